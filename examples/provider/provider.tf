@@ -2,5 +2,13 @@ provider "uptimekuma" {
   base_url = "http://localhost:3001"  # Your Uptime Kuma instance URL
   username = "admin"                  # Username for authentication
   password = "password"               # Password for authentication
+  # access_token = var.uptimekuma_token  # Optional: Pre-issued token instead of username/password
+  # totp_secret  = var.uptimekuma_totp_secret  # Optional: 2FA secret, when two-factor auth is enabled
   # insecure_https = true             # Optional: Skip TLS certificate verification
+  # ca_cert_file   = "/etc/ssl/internal-ca.pem"  # Optional: Trust an internal CA
+  # client_cert_pem = file("client.crt")         # Optional: Client certificate for mTLS
+  # client_key_pem  = file("client.key")         # Optional: Client key for mTLS
+  # max_retries    = 3                # Optional: Retries for 429/502/503/504 responses on reads
+  # retry_wait_max = 30               # Optional: Maximum seconds to wait between retries
+  # default_notification_ids = [1]    # Optional: Notifications bound to every monitor by default
 }
\ No newline at end of file
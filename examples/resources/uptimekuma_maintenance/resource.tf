@@ -0,0 +1,32 @@
+resource "uptimekuma_maintenance" "weekend_patching" {
+  title       = "Weekend patching"
+  description = "OS updates on the application servers"
+  strategy    = "recurring-weekday"
+  weekdays    = [0, 6]
+  timezone    = "Europe/Berlin"
+
+  time_range = {
+    start = "02:00"
+    end   = "04:00"
+  }
+}
+
+resource "uptimekuma_maintenance" "database_migration" {
+  title    = "Database migration"
+  strategy = "single"
+
+  date_range = {
+    start = "2025-06-01 22:00:00"
+    end   = "2025-06-02 02:00:00"
+  }
+}
+
+resource "uptimekuma_maintenance" "nightly_backup" {
+  title            = "Nightly backup"
+  strategy         = "cron"
+  cron             = "30 3 * * *"
+  duration_minutes = 45
+
+  # Paused maintenance windows are kept but never start.
+  active = false
+}
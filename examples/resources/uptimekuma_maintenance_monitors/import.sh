@@ -0,0 +1,2 @@
+# Importing by maintenance ID adopts every monitor currently attached to the maintenance window.
+terraform import uptimekuma_maintenance_monitors.payments 1
@@ -0,0 +1,19 @@
+resource "uptimekuma_maintenance" "org_patching" {
+  title    = "Org-wide patch window"
+  strategy = "recurring-weekday"
+  weekdays = [0]
+
+  time_range = {
+    start = "01:00"
+    end   = "03:00"
+  }
+}
+
+# Puts this module's monitors under the shared maintenance window.
+resource "uptimekuma_maintenance_monitors" "payments" {
+  maintenance_id = uptimekuma_maintenance.org_patching.id
+  monitor_ids = [
+    uptimekuma_monitor.http_example.id,
+    uptimekuma_monitor.ping_example.id,
+  ]
+}
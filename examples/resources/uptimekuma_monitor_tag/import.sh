@@ -0,0 +1,2 @@
+# Monitor tags can be imported with <monitor_id>:<tag_id>:<value>.
+terraform import uptimekuma_monitor_tag.sre 12:3:sre
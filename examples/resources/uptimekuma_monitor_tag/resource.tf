@@ -0,0 +1,11 @@
+resource "uptimekuma_tag" "oncall" {
+  name  = "oncall"
+  color = "red"
+}
+
+# Tags a monitor owned by another module without managing the monitor itself.
+resource "uptimekuma_monitor_tag" "sre" {
+  monitor_id = uptimekuma_monitor.http_example.id
+  tag_id     = uptimekuma_tag.oncall.id
+  value      = "sre"
+}
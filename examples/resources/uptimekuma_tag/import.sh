@@ -0,0 +1,2 @@
+# Tags can be imported by ID.
+terraform import uptimekuma_tag.production 1
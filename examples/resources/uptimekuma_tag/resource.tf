@@ -0,0 +1,10 @@
+resource "uptimekuma_tag" "production" {
+  name  = "production"
+  color = "#059669"
+}
+
+# Named Uptime Kuma palette colours are accepted as well.
+resource "uptimekuma_tag" "oncall" {
+  name  = "oncall"
+  color = "red"
+}
@@ -5,8 +5,11 @@ package client
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strings"
@@ -14,6 +17,13 @@ import (
 	"time"
 )
 
+const (
+	// defaultTokenLifetime is used when the token does not carry an exp claim.
+	defaultTokenLifetime = 59 * time.Minute
+	// tokenExpiryLeeway is subtracted from the exp claim so tokens are refreshed early.
+	tokenExpiryLeeway = 30 * time.Second
+)
+
 // TokenResponse represents the OAuth token response from the API.
 type TokenResponse struct {
 	AccessToken string `json:"access_token"`
@@ -25,6 +35,9 @@ type AuthClient struct {
 	baseURL     string
 	username    string
 	password    string
+	accessToken string
+	totpSecret  string
+	now         func() time.Time
 	httpClient  *http.Client
 	token       string
 	tokenExpiry time.Time
@@ -43,19 +56,50 @@ func NewAuthClient(baseURL, username, password string, httpClient *http.Client)
 		baseURL:    baseURL,
 		username:   username,
 		password:   password,
+		now:        time.Now,
 		httpClient: httpClient,
 	}
 }
 
+// NewAuthClientWithToken creates an auth client that uses a pre-issued access token
+// instead of logging in with a username and password.
+func NewAuthClientWithToken(baseURL, accessToken string, httpClient *http.Client) *AuthClient {
+	a := NewAuthClient(baseURL, "", "", httpClient)
+	a.accessToken = accessToken
+	return a
+}
+
+// SetTOTPSecret sets the base32 encoded secret used to generate two-factor codes at login.
+func (a *AuthClient) SetTOTPSecret(secret string) error {
+	if _, err := decodeTOTPSecret(secret); err != nil {
+		return err
+	}
+
+	a.totpSecret = secret
+	return nil
+}
+
+// canRefresh reports whether the client can obtain a new token by logging in.
+func (a *AuthClient) canRefresh() bool {
+	return a.accessToken == ""
+}
+
 // GetToken returns a valid authentication token, refreshing if necessary.
 func (a *AuthClient) GetToken(ctx context.Context) (string, error) {
+	if !a.canRefresh() {
+		if expiry, ok := jwtExpiry(a.accessToken); ok && a.now().After(expiry) {
+			return "", fmt.Errorf("access token expired at %s", expiry.Format(time.RFC3339))
+		}
+		return a.accessToken, nil
+	}
+
 	a.mutex.RLock()
 	token := a.token
 	expiry := a.tokenExpiry
 	a.mutex.RUnlock()
 
 	// Check if we need a new token
-	if token == "" || time.Now().After(expiry) {
+	if token == "" || a.now().After(expiry) {
 		return a.refreshToken(ctx)
 	}
 
@@ -67,19 +111,69 @@ func (a *AuthClient) refreshToken(ctx context.Context) (string, error) {
 	a.mutex.Lock()
 	defer a.mutex.Unlock()
 
-	if a.token != "" && time.Now().Before(a.tokenExpiry) {
+	if a.token != "" && a.now().Before(a.tokenExpiry) {
 		return a.token, nil
 	}
 
+	codes, err := a.totpCodes()
+	if err != nil {
+		return "", err
+	}
+
+	// Try the current TOTP code first, and the adjacent ones only when the server
+	// rejected the code itself. Without a TOTP secret there is a single attempt.
+	var tokenResp *TokenResponse
+	for _, code := range codes {
+		tokenResp, err = a.login(ctx, code)
+		if err == nil || !errors.Is(err, ErrTOTPRejected) {
+			break
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// Store the token and its expiry.
+	a.token = tokenResp.AccessToken
+	a.tokenExpiry = tokenExpiry(tokenResp.AccessToken, a.now())
+
+	return a.token, nil
+}
+
+// totpCodes returns the two-factor codes to try when logging in, or a single empty
+// code when no TOTP secret is configured.
+func (a *AuthClient) totpCodes() ([]string, error) {
+	if a.totpSecret == "" {
+		return []string{""}, nil
+	}
+
+	step := a.now().Unix() / int64(totpPeriod.Seconds())
+	codes := make([]string, 0, len(totpSkewSteps))
+	for _, skew := range totpSkewSteps {
+		code, err := generateTOTPAtStep(a.totpSecret, step+skew)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate TOTP code: %w", err)
+		}
+		codes = append(codes, code)
+	}
+
+	return codes, nil
+}
+
+// login posts the credentials, and the TOTP code when set, to the login endpoint.
+func (a *AuthClient) login(ctx context.Context, code string) (*TokenResponse, error) {
 	// Prepare the authentication request.
 	data := url.Values{}
 	data.Set("username", a.username)
 	data.Set("password", a.password)
+	if code != "" {
+		data.Set("token", code)
+	}
 
 	authURL := fmt.Sprintf("%s/login/access-token", a.baseURL)
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, authURL, strings.NewReader(data.Encode()))
 	if err != nil {
-		return "", fmt.Errorf("failed to create auth request: %w", err)
+		return nil, fmt.Errorf("failed to create auth request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
@@ -88,30 +182,109 @@ func (a *AuthClient) refreshToken(ctx context.Context) (string, error) {
 	// Execute the request.
 	resp, err := a.httpClient.Do(req)
 	if err != nil {
-		return "", fmt.Errorf("failed to execute auth request: %w", err)
+		return nil, fmt.Errorf("failed to execute auth request: %w", err)
 	}
 	defer resp.Body.Close()
 
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read auth response: %w", err)
+	}
+
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("authentication failed with status code: %d", resp.StatusCode)
+		if code == "" && isTOTPRequired(bodyBytes) {
+			return nil, fmt.Errorf("authentication failed: %w", ErrTOTPRequired)
+		}
+		apiErr := newAPIError(http.MethodPost, "/login/access-token", resp.StatusCode, bodyBytes)
+		if code != "" && isTOTPRejected(bodyBytes) {
+			return nil, fmt.Errorf("authentication failed: %w: %w", ErrTOTPRejected, apiErr)
+		}
+		return nil, fmt.Errorf("authentication failed: %w", apiErr)
 	}
 
 	// Parse the token response.
 	var tokenResp TokenResponse
-	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
-		return "", fmt.Errorf("failed to decode token response: %w", err)
+	if err := json.Unmarshal(bodyBytes, &tokenResp); err != nil {
+		return nil, fmt.Errorf("failed to decode token response: %w", err)
 	}
 
 	if tokenResp.AccessToken == "" {
-		return "", fmt.Errorf("received empty access token")
+		if code == "" && isTOTPRequired(bodyBytes) {
+			return nil, fmt.Errorf("authentication failed: %w", ErrTOTPRequired)
+		}
+		return nil, fmt.Errorf("received empty access token")
 	}
 
-	// Store the token and set expiry (assuming 1 hour validity, adjust as needed).
-	a.token = tokenResp.AccessToken
-	// Set expiry slightly shorter than actual to avoid race conditions.
-	a.tokenExpiry = time.Now().Add(59 * time.Minute) // Example: 59 minutes.
+	return &tokenResp, nil
+}
 
-	return a.token, nil
+// isTOTPRequired reports whether a login response asks for a two-factor code.
+// Uptime Kuma signals this with a tokenRequired flag, which the web API either
+// returns as JSON or includes in the error detail.
+func isTOTPRequired(body []byte) bool {
+	var flag struct {
+		TokenRequired bool `json:"tokenRequired"`
+	}
+	if err := json.Unmarshal(body, &flag); err == nil && flag.TokenRequired {
+		return true
+	}
+
+	lower := strings.ToLower(string(body))
+	return strings.Contains(lower, "tokenrequired") || strings.Contains(lower, "token required")
+}
+
+// isTOTPRejected reports whether a login response rejects the two-factor code, as
+// opposed to the username or password. Uptime Kuma reports this as authInvalidToken,
+// or as "Invalid Token!" in older releases.
+func isTOTPRejected(body []byte) bool {
+	lower := strings.ToLower(string(body))
+	return strings.Contains(lower, "authinvalidtoken") || strings.Contains(lower, "invalid token")
+}
+
+// invalidateToken discards the cached token if it is still the one that was rejected.
+// Comparing against the rejected token means concurrent 401s only trigger a single login:
+// callers that lose the race find a fresh token already cached in refreshToken.
+func (a *AuthClient) invalidateToken(rejected string) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.token == rejected {
+		a.token = ""
+		a.tokenExpiry = time.Time{}
+	}
+}
+
+// tokenExpiry returns when a token should be refreshed, based on the JWT exp claim.
+// Tokens without a readable exp claim are assumed to be valid for defaultTokenLifetime.
+func tokenExpiry(token string, now time.Time) time.Time {
+	if expiry, ok := jwtExpiry(token); ok {
+		// Refresh slightly before the actual expiry to avoid using a token as it expires.
+		return expiry.Add(-tokenExpiryLeeway)
+	}
+
+	return now.Add(defaultTokenLifetime)
+}
+
+// jwtExpiry returns the exp claim of a JWT, if the token carries one.
+func jwtExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	var claims struct {
+		Exp float64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp <= 0 {
+		return time.Time{}, false
+	}
+
+	return time.Unix(int64(claims.Exp), 0), true
 }
 
 // AddAuthHeader adds the authorization header to an HTTP request.
@@ -143,22 +316,58 @@ type authTransport struct {
 }
 
 // RoundTrip implements the http.RoundTripper interface.
+// When the server rejects the token with a 401, the cached token is discarded and the
+// request is replayed once with a freshly issued token.
 func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	// Clone the request to avoid modifying the original request context.
-	req2 := req.Clone(req.Context())
-
-	// Add authentication header
-	if err := t.authClient.AddAuthHeader(req.Context(), req2); err != nil {
-		// Handle token fetch error before sending the request.
-		return nil, fmt.Errorf("failed to add auth header: %w", err)
-	}
-
 	// Use the base transport or default if none provided.
 	base := t.base
 	if base == nil {
 		base = http.DefaultTransport
 	}
 
+	token, err := t.authClient.GetToken(req.Context())
+	if err != nil {
+		// Handle token fetch error before sending the request.
+		return nil, fmt.Errorf("failed to add auth header: %w", err)
+	}
+
 	// Perform the actual request using the base transport.
-	return base.RoundTrip(req2)
+	resp, err := base.RoundTrip(authorizedRequest(req, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// A pre-issued token cannot be renewed, and the request can only be
+	// replayed if its body can be read again.
+	if !t.authClient.canRefresh() || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+		return resp, nil
+	}
+
+	t.authClient.invalidateToken(token)
+	token, err = t.authClient.GetToken(req.Context())
+	if err != nil {
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to re-authenticate: %w", err)
+	}
+
+	retry := authorizedRequest(req, token)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retry.Body = body
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	return base.RoundTrip(retry)
+}
+
+// authorizedRequest clones req with the bearer token set, leaving the original untouched.
+func authorizedRequest(req *http.Request, token string) *http.Request {
+	req2 := req.Clone(req.Context())
+	req2.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	return req2
 }
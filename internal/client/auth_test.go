@@ -0,0 +1,375 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// makeJWT returns an unsigned JWT carrying the given exp claim.
+func makeJWT(exp int64) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"sub":"admin","exp":%d}`, exp)))
+	return header + "." + payload + ".signature"
+}
+
+// rotatingAuthServer issues a new token on every login and only accepts the most recent one.
+type rotatingAuthServer struct {
+	mu       sync.Mutex
+	current  string
+	logins   atomic.Int32
+	requests atomic.Int32
+	bodies   []string
+}
+
+// rotate invalidates the current token, as a server restart or secret rotation would.
+func (s *rotatingAuthServer) rotate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.current = ""
+}
+
+func (s *rotatingAuthServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/login/access-token" {
+		n := s.logins.Add(1)
+		s.mu.Lock()
+		s.current = fmt.Sprintf("token-%d", n)
+		token := s.current
+		s.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(TokenResponse{AccessToken: token, TokenType: "Bearer"})
+		return
+	}
+
+	s.requests.Add(1)
+
+	s.mu.Lock()
+	valid := s.current != "" && r.Header.Get("Authorization") == "Bearer "+s.current
+	if body, err := io.ReadAll(r.Body); err == nil && len(body) > 0 {
+		s.bodies = append(s.bodies, string(body))
+	}
+	s.mu.Unlock()
+
+	if !valid {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"detail":"Could not validate credentials"}`))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(`{"status":"success"}`))
+}
+
+// newRotatingAuthClient returns a client talking to a rotatingAuthServer.
+func newRotatingAuthClient(t *testing.T) (*Client, *rotatingAuthServer) {
+	t.Helper()
+
+	authServer := &rotatingAuthServer{}
+	server := httptest.NewServer(authServer)
+	t.Cleanup(server.Close)
+
+	client, err := New(&Config{
+		BaseURL:  server.URL,
+		Username: "testuser",
+		Password: "testpass",
+		Timeout:  5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	return client, authServer
+}
+
+// TestReauthenticateOn401 tests that a rejected token is replaced and the request replayed with its body.
+func TestReauthenticateOn401(t *testing.T) {
+	client, authServer := newRotatingAuthClient(t)
+	ctx := context.Background()
+
+	if err := client.Get(ctx, "/test", nil); err != nil {
+		t.Fatalf("Initial request failed: %v", err)
+	}
+
+	authServer.rotate()
+
+	var result map[string]interface{}
+	if err := client.Post(ctx, "/test", strings.NewReader(`{"key":"value"}`), &result); err != nil {
+		t.Fatalf("Expected request to succeed after re-authentication, got: %v", err)
+	}
+	if result["status"] != "success" {
+		t.Errorf("Unexpected result: %v", result)
+	}
+
+	if got := authServer.logins.Load(); got != 2 {
+		t.Errorf("Expected 2 logins, got %d", got)
+	}
+	if len(authServer.bodies) != 2 || authServer.bodies[0] != `{"key":"value"}` || authServer.bodies[1] != `{"key":"value"}` {
+		t.Errorf("Expected body to be sent on both attempts, got %q", authServer.bodies)
+	}
+}
+
+// TestReauthenticateOnlyOnce tests that a request is replayed at most once.
+func TestReauthenticateOnlyOnce(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/login/access-token" {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(TokenResponse{AccessToken: "test-token-12345", TokenType: "Bearer"})
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client, err := New(&Config{
+		BaseURL:  server.URL,
+		Username: "testuser",
+		Password: "testpass",
+		Timeout:  5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	err = client.Get(context.Background(), "/test", nil)
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("Expected ErrUnauthorized, got: %v", err)
+	}
+}
+
+// TestConcurrentReauthenticationLogsInOnce tests that parallel 401s share a single login.
+func TestConcurrentReauthenticationLogsInOnce(t *testing.T) {
+	client, authServer := newRotatingAuthClient(t)
+	ctx := context.Background()
+
+	if err := client.Get(ctx, "/test", nil); err != nil {
+		t.Fatalf("Initial request failed: %v", err)
+	}
+
+	authServer.rotate()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- client.Get(ctx, "/test", nil)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("Request failed: %v", err)
+		}
+	}
+
+	if got := authServer.logins.Load(); got != 2 {
+		t.Errorf("Expected 2 logins, got %d", got)
+	}
+}
+
+// TestTokenExpiry tests that token expiry follows the JWT exp claim.
+func TestTokenExpiry(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	exp := now.Add(15 * time.Minute)
+
+	tests := []struct {
+		name  string
+		token string
+		want  time.Time
+	}{
+		{name: "exp claim", token: makeJWT(exp.Unix()), want: exp.Add(-tokenExpiryLeeway)},
+		{name: "opaque token", token: "test-token-12345", want: now.Add(defaultTokenLifetime)},
+		{name: "invalid payload", token: "header.!!!.signature", want: now.Add(defaultTokenLifetime)},
+		{name: "missing exp", token: "e30." + base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"admin"}`)) + ".sig", want: now.Add(defaultTokenLifetime)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tokenExpiry(tt.token, now); !got.Equal(tt.want) {
+				t.Errorf("tokenExpiry() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestExpiredJWTIsRefreshed tests that a token past its exp claim is not reused.
+func TestExpiredJWTIsRefreshed(t *testing.T) {
+	var logins atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logins.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		// Issue a token that has already expired.
+		_ = json.NewEncoder(w).Encode(TokenResponse{AccessToken: makeJWT(time.Now().Add(-time.Minute).Unix()), TokenType: "Bearer"})
+	}))
+	defer server.Close()
+
+	auth := NewAuthClient(server.URL, "testuser", "testpass", nil)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := auth.GetToken(ctx); err != nil {
+			t.Fatalf("GetToken failed: %v", err)
+		}
+	}
+
+	if got := logins.Load(); got != 2 {
+		t.Errorf("Expected a login for each expired token, got %d", got)
+	}
+}
+
+// TestAccessTokenSkipsLogin tests that a pre-issued token is used without calling the login endpoint.
+func TestAccessTokenSkipsLogin(t *testing.T) {
+	var logins, requests atomic.Int32
+	token := makeJWT(time.Now().Add(time.Hour).Unix())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/login/access-token" {
+			logins.Add(1)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		requests.Add(1)
+		if r.Header.Get("Authorization") != "Bearer "+token || r.URL.Path != "/test" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"success"}`))
+	}))
+	defer server.Close()
+
+	client, err := New(&Config{
+		BaseURL:     server.URL,
+		AccessToken: token,
+		Timeout:     5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx := context.Background()
+	if err := client.Get(ctx, "/test", nil); err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+
+	// A rejected pre-issued token is not replayed, since it cannot be renewed.
+	err = client.Get(ctx, "/rejected", nil)
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("Expected ErrUnauthorized, got: %v", err)
+	}
+
+	if got := logins.Load(); got != 0 {
+		t.Errorf("Expected no logins, got %d", got)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("Expected 2 requests, got %d", got)
+	}
+}
+
+// TestExpiredAccessToken tests that an expired pre-issued token fails before any request is sent.
+func TestExpiredAccessToken(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+	}))
+	defer server.Close()
+
+	client, err := New(&Config{
+		BaseURL:     server.URL,
+		AccessToken: makeJWT(time.Now().Add(-time.Minute).Unix()),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	err = client.Get(context.Background(), "/test", nil)
+	if err == nil || !strings.Contains(err.Error(), "access token expired") {
+		t.Fatalf("Expected expired token error, got: %v", err)
+	}
+	if got := requests.Load(); got != 0 {
+		t.Errorf("Expected no requests, got %d", got)
+	}
+}
+
+// TestGetTokenUsesClock tests that token expiry is checked against the client clock.
+func TestGetTokenUsesClock(t *testing.T) {
+	var logins atomic.Int32
+	issued := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logins.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(TokenResponse{AccessToken: makeJWT(issued.Add(time.Hour).Unix()), TokenType: "Bearer"})
+	}))
+	defer server.Close()
+
+	now := issued
+	auth := NewAuthClient(server.URL, "testuser", "testpass", nil)
+	auth.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	for _, step := range []time.Duration{0, 30 * time.Minute, 2 * time.Hour} {
+		now = issued.Add(step)
+		if _, err := auth.GetToken(ctx); err != nil {
+			t.Fatalf("GetToken failed: %v", err)
+		}
+	}
+
+	// The token is reused until the clock passes its exp claim.
+	if got := logins.Load(); got != 2 {
+		t.Errorf("Expected 2 logins, got %d", got)
+	}
+
+	tokenAuth := NewAuthClientWithToken(server.URL, makeJWT(issued.Add(time.Hour).Unix()), nil)
+	tokenAuth.now = func() time.Time { return issued.Add(2 * time.Hour) }
+	if _, err := tokenAuth.GetToken(ctx); err == nil || !strings.Contains(err.Error(), "access token expired") {
+		t.Fatalf("Expected expired token error, got: %v", err)
+	}
+}
+
+// TestCredentialValidation tests the credential combinations accepted by New.
+func TestCredentialValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr string
+	}{
+		{name: "username and password", config: Config{Username: "admin", Password: "secret"}},
+		{name: "access token", config: Config{AccessToken: "token"}},
+		{name: "missing password", config: Config{Username: "admin"}, wantErr: "password is required"},
+		{name: "missing credentials", config: Config{}, wantErr: "username is required"},
+		{name: "token and password", config: Config{AccessToken: "token", Password: "secret"}, wantErr: "mutually exclusive"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tt.config
+			config.BaseURL = "http://localhost:3001"
+
+			_, err := New(&config)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("Expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -4,12 +4,15 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Config holds the configuration for the Uptime Kuma client.
@@ -17,9 +20,15 @@ type Config struct {
 	BaseURL          string
 	Username         string
 	Password         string
+	AccessToken      string
+	TOTPSecret       string
 	Timeout          time.Duration
 	InsecureHTTPS    bool
+	CACertPEM        string
+	ClientCertPEM    string
+	ClientKeyPEM     string
 	CustomHTTPClient *http.Client
+	RetryPolicy      *RetryPolicy
 }
 
 // Client is the API client for Uptime Kuma.
@@ -35,33 +44,61 @@ func New(config *Config) (*Client, error) {
 	if config.BaseURL == "" {
 		return nil, fmt.Errorf("base URL is required")
 	}
-	if config.Username == "" {
-		return nil, fmt.Errorf("username is required")
-	}
-	if config.Password == "" {
-		return nil, fmt.Errorf("password is required")
+	if config.AccessToken == "" {
+		if config.Username == "" {
+			return nil, fmt.Errorf("username is required")
+		}
+		if config.Password == "" {
+			return nil, fmt.Errorf("password is required")
+		}
+	} else if config.Username != "" || config.Password != "" {
+		return nil, fmt.Errorf("access token and username/password are mutually exclusive")
+	} else if config.TOTPSecret != "" {
+		return nil, fmt.Errorf("TOTP secret cannot be used with an access token")
 	}
 
 	// Set defaults.
 	if config.Timeout == 0 {
 		config.Timeout = 30 * time.Second
 	}
+	if config.RetryPolicy == nil {
+		config.RetryPolicy = DefaultRetryPolicy()
+	}
+	if config.RetryPolicy.MaxAttempts < 1 {
+		config.RetryPolicy.MaxAttempts = 1
+	}
 
 	// Create HTTP client.
 	httpClient := config.CustomHTTPClient
 	if httpClient == nil {
+		tlsConfig, err := newTLSConfig(config)
+		if err != nil {
+			return nil, err
+		}
+
 		httpClient = &http.Client{
-			Timeout: config.Timeout,
+			Transport: newTransport(tlsConfig),
+			Timeout:   config.Timeout,
 		}
 	}
 
 	// Create auth client.
-	authClient := NewAuthClient(
-		config.BaseURL,
-		config.Username,
-		config.Password,
-		httpClient,
-	)
+	var authClient *AuthClient
+	if config.AccessToken != "" {
+		authClient = NewAuthClientWithToken(config.BaseURL, config.AccessToken, httpClient)
+	} else {
+		authClient = NewAuthClient(
+			config.BaseURL,
+			config.Username,
+			config.Password,
+			httpClient,
+		)
+		if config.TOTPSecret != "" {
+			if err := authClient.SetTOTPSecret(config.TOTPSecret); err != nil {
+				return nil, err
+			}
+		}
+	}
 
 	// Create API client with authenticated http client.
 	return &Client{
@@ -72,31 +109,87 @@ func New(config *Config) (*Client, error) {
 }
 
 // doRequest performs an HTTP request and decodes the response.
+// Requests are retried according to the configured RetryPolicy.
 func (c *Client) doRequest(ctx context.Context, method, path string, body io.Reader, result interface{}) error {
+	// Buffer the body so it can be replayed on every attempt.
+	var bodyBytes []byte
+	if body != nil {
+		var err error
+		bodyBytes, err = io.ReadAll(body)
+		if err != nil {
+			return fmt.Errorf("failed to read request body: %w", err)
+		}
+	}
+
+	policy := c.config.RetryPolicy
+	for attempt := 1; ; attempt++ {
+		resp, err := c.send(ctx, method, path, body != nil, bodyBytes)
+
+		retry := policy.canRetry(method, attempt) && ctx.Err() == nil
+		if err != nil {
+			if !retry || !policy.retryableError(err) {
+				return err
+			}
+		} else if !retry || !policy.retryableStatus(resp.StatusCode) {
+			return c.handleResponse(method, path, resp, result)
+		}
+
+		wait := policy.backoff(attempt, resp)
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		tflog.Debug(ctx, "Retrying Uptime Kuma API request", map[string]interface{}{
+			"method":  method,
+			"path":    path,
+			"attempt": attempt,
+			"wait":    wait.String(),
+		})
+
+		if err := sleepContext(ctx, wait); err != nil {
+			return fmt.Errorf("failed to execute request: %w", err)
+		}
+	}
+}
+
+// send executes a single attempt of a request.
+func (c *Client) send(ctx context.Context, method, path string, hasBody bool, bodyBytes []byte) (*http.Response, error) {
 	// Create request.
+	var body io.Reader
+	if hasBody {
+		body = bytes.NewReader(bodyBytes)
+	}
+
 	url := fmt.Sprintf("%s%s", c.config.BaseURL, path)
 	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Set common headers.
 	req.Header.Set("Accept", "application/json")
-	if body != nil {
+	if hasBody {
 		req.Header.Set("Content-Type", "application/json")
 	}
 
 	// Execute request.
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to execute request: %w", err)
+		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
+
+	return resp, nil
+}
+
+// handleResponse checks the status code and decodes the response body into result.
+func (c *Client) handleResponse(method, path string, resp *http.Response, result interface{}) error {
 	defer resp.Body.Close()
 
 	// Check status code.
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+		return newAPIError(method, path, resp.StatusCode, bodyBytes)
 	}
 
 	// Decode response if result is provided.
@@ -133,3 +226,21 @@ func (c *Client) Patch(ctx context.Context, path string, body io.Reader, result
 func (c *Client) Delete(ctx context.Context, path string, result interface{}) error {
 	return c.doRequest(ctx, http.MethodDelete, path, nil, result)
 }
+
+// marshalWithNullFields encodes v and sets the given JSON fields to null, so that
+// PATCH requests clear them instead of leaving them unchanged.
+func marshalWithNullFields(v interface{}, nullFields []string) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(nullFields) == 0 {
+		return data, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for _, name := range nullFields {
+		fields[name] = json.RawMessage("null")
+	}
+	return json.Marshal(fields)
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Sentinel errors matched by APIError through errors.Is.
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrConflict     = errors.New("conflict")
+	ErrValidation   = errors.New("validation failed")
+)
+
+// ValidationError describes a single field error from a FastAPI HTTPValidationError body.
+type ValidationError struct {
+	Loc  []interface{} `json:"loc"`
+	Msg  string        `json:"msg"`
+	Type string        `json:"type"`
+}
+
+// Field returns the dotted location of the invalid field, without the leading "body" segment.
+func (v ValidationError) Field() string {
+	parts := make([]string, 0, len(v.Loc))
+	for i, loc := range v.Loc {
+		s := fmt.Sprint(loc)
+		if i == 0 && s == "body" && len(v.Loc) > 1 {
+			continue
+		}
+		parts = append(parts, s)
+	}
+	return strings.Join(parts, ".")
+}
+
+// APIError is returned for every non-2xx response from the Uptime Kuma API.
+type APIError struct {
+	StatusCode       int
+	Method           string
+	Path             string
+	Detail           string
+	ValidationErrors []ValidationError
+	Body             string
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("%s %s failed with status %d", e.Method, e.Path, e.StatusCode)
+
+	switch {
+	case len(e.ValidationErrors) > 0:
+		fields := make([]string, 0, len(e.ValidationErrors))
+		for _, v := range e.ValidationErrors {
+			fields = append(fields, fmt.Sprintf("%s: %s", v.Field(), v.Msg))
+		}
+		return msg + ": " + strings.Join(fields, "; ")
+	case e.Detail != "":
+		return msg + ": " + e.Detail
+	case e.Body != "":
+		return msg + ": " + e.Body
+	}
+
+	return msg
+}
+
+// Is reports whether the error matches one of the package sentinel errors.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrValidation:
+		return e.StatusCode == http.StatusUnprocessableEntity
+	}
+	return false
+}
+
+// newAPIError builds an APIError, decoding the FastAPI detail from the body when present.
+func newAPIError(method, path string, statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		Method:     method,
+		Path:       path,
+		Body:       strings.TrimSpace(string(body)),
+	}
+
+	var payload struct {
+		Detail json.RawMessage `json:"detail"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil || len(payload.Detail) == 0 {
+		return apiErr
+	}
+
+	// Detail is either a plain message or a list of validation errors.
+	var detail string
+	if err := json.Unmarshal(payload.Detail, &detail); err == nil {
+		apiErr.Detail = detail
+		return apiErr
+	}
+
+	var validationErrors []ValidationError
+	if err := json.Unmarshal(payload.Detail, &validationErrors); err == nil {
+		apiErr.ValidationErrors = validationErrors
+		return apiErr
+	}
+
+	apiErr.Detail = string(payload.Detail)
+	return apiErr
+}
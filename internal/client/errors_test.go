@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestAPIErrors tests that non-2xx responses are returned as typed errors.
+func TestAPIErrors(t *testing.T) {
+	// Setup mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/login/access-token" {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(TokenResponse{
+				AccessToken: "test-token-12345",
+				TokenType:   "Bearer",
+			})
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/monitors/404":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"detail":"Monitor not found"}`))
+		case "/monitors/409":
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"detail":"Slug already exists"}`))
+		case "/monitors/422":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"detail":[{"loc":["body","url"],"msg":"field required","type":"value_error.missing"},{"loc":["body","interval"],"msg":"value is not a valid integer","type":"type_error.integer"}]}`))
+		case "/monitors/500":
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`Internal Server Error`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(&Config{
+		BaseURL:  server.URL,
+		Username: "testuser",
+		Password: "testpass",
+		Timeout:  5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		path       string
+		statusCode int
+		sentinel   error
+		message    string
+	}{
+		{name: "not found", path: "/monitors/404", statusCode: http.StatusNotFound, sentinel: ErrNotFound, message: "Monitor not found"},
+		{name: "conflict", path: "/monitors/409", statusCode: http.StatusConflict, sentinel: ErrConflict, message: "Slug already exists"},
+		{name: "validation", path: "/monitors/422", statusCode: http.StatusUnprocessableEntity, sentinel: ErrValidation, message: "url: field required; interval: value is not a valid integer"},
+		{name: "server error", path: "/monitors/500", statusCode: http.StatusInternalServerError, message: "Internal Server Error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := client.Get(ctx, tt.path, nil)
+			if err == nil {
+				t.Fatalf("Expected error, got nil")
+			}
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("Expected *APIError, got %T: %v", err, err)
+			}
+			if apiErr.StatusCode != tt.statusCode {
+				t.Errorf("Expected status %d, got %d", tt.statusCode, apiErr.StatusCode)
+			}
+			if apiErr.Method != http.MethodGet || apiErr.Path != tt.path {
+				t.Errorf("Unexpected request in error: %s %s", apiErr.Method, apiErr.Path)
+			}
+			if tt.sentinel != nil && !errors.Is(err, tt.sentinel) {
+				t.Errorf("Expected errors.Is(err, %v) to be true", tt.sentinel)
+			}
+			if !strings.Contains(err.Error(), tt.message) {
+				t.Errorf("Expected error to contain %q, got %q", tt.message, err.Error())
+			}
+		})
+	}
+
+	// Errors wrapped by the typed helpers still match the sentinels.
+	_, err = client.GetMonitor(ctx, 404)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected GetMonitor error to match ErrNotFound, got %v", err)
+	}
+
+	var apiErr *APIError
+	err = client.Get(ctx, "/monitors/422", nil)
+	if !errors.As(err, &apiErr) || len(apiErr.ValidationErrors) != 2 {
+		t.Fatalf("Expected 2 validation errors, got %v", err)
+	}
+	if apiErr.ValidationErrors[0].Field() != "url" {
+		t.Errorf("Expected field 'url', got %q", apiErr.ValidationErrors[0].Field())
+	}
+}
+
+// TestAuthenticationErrorIsUnauthorized tests that login failures match ErrUnauthorized.
+func TestAuthenticationErrorIsUnauthorized(t *testing.T) {
+	// Setup mock server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"detail":"Incorrect username or password"}`))
+	}))
+	defer server.Close()
+
+	client, err := New(&Config{
+		BaseURL:  server.URL,
+		Username: "invalid",
+		Password: "invalid",
+		Timeout:  5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	err = client.Get(context.Background(), "/monitors", nil)
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("Expected ErrUnauthorized, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "Incorrect username or password") {
+		t.Errorf("Expected error to include server detail, got %q", err.Error())
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// MaintenanceStrategy represents how a maintenance window is scheduled.
+type MaintenanceStrategy string
+
+// Maintenance strategies.
+const (
+	MaintenanceStrategyManual              MaintenanceStrategy = "manual"
+	MaintenanceStrategySingle              MaintenanceStrategy = "single"
+	MaintenanceStrategyRecurringInterval   MaintenanceStrategy = "recurring-interval"
+	MaintenanceStrategyRecurringWeekday    MaintenanceStrategy = "recurring-weekday"
+	MaintenanceStrategyRecurringDayOfMonth MaintenanceStrategy = "recurring-day-of-month"
+	MaintenanceStrategyCron                MaintenanceStrategy = "cron"
+)
+
+// MaintenanceTime is a time of day used in a maintenance time range.
+type MaintenanceTime struct {
+	Hours   int `json:"hours"`
+	Minutes int `json:"minutes"`
+}
+
+// Maintenance represents an Uptime Kuma maintenance window.
+type Maintenance struct {
+	ID              int                 `json:"id,omitempty"`
+	Title           string              `json:"title"`
+	Description     string              `json:"description"`
+	Strategy        MaintenanceStrategy `json:"strategy"`
+	Active          bool                `json:"active"`
+	IntervalDay     int                 `json:"intervalDay,omitempty"`
+	DateRange       []string            `json:"dateRange,omitempty"`
+	TimeRange       []MaintenanceTime   `json:"timeRange,omitempty"`
+	Weekdays        []int               `json:"weekdays"`
+	DaysOfMonth     []interface{}       `json:"daysOfMonth"`
+	Cron            string              `json:"cron,omitempty"`
+	DurationMinutes int                 `json:"durationMinutes,omitempty"`
+	TimezoneOption  string              `json:"timezoneOption,omitempty"`
+	Status          string              `json:"status,omitempty"`
+
+	// NullFields lists JSON field names sent as null, so an update clears them
+	// instead of omitting them.
+	NullFields []string `json:"-"`
+}
+
+// MarshalJSON encodes the maintenance window, sending the fields listed in NullFields as null.
+func (m Maintenance) MarshalJSON() ([]byte, error) {
+	type maintenance Maintenance
+	return marshalWithNullFields(maintenance(m), m.NullFields)
+}
+
+// MaintenanceMonitor is a monitor affected by a maintenance window.
+type MaintenanceMonitor struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type maintenanceListAPIResponse struct {
+	Maintenances []Maintenance `json:"maintenances"`
+}
+
+type maintenanceAPIResponse struct {
+	Maintenance Maintenance `json:"maintenance"`
+}
+
+type createMaintenanceAPIResponse struct {
+	Msg           string `json:"msg"`
+	MaintenanceID int    `json:"maintenanceID"`
+}
+
+type maintenanceMonitorsAPIResponse struct {
+	Monitors []MaintenanceMonitor `json:"monitors"`
+}
+
+// GetMaintenances retrieves all maintenance windows.
+func (c *Client) GetMaintenances(ctx context.Context) ([]Maintenance, error) {
+	var result maintenanceListAPIResponse
+	if err := c.Get(ctx, "/maintenances", &result); err != nil {
+		return nil, fmt.Errorf("failed to get maintenances: %w", err)
+	}
+	return result.Maintenances, nil
+}
+
+// GetMaintenance retrieves a specific maintenance window by ID.
+func (c *Client) GetMaintenance(ctx context.Context, id int) (*Maintenance, error) {
+	var result maintenanceAPIResponse
+	path := fmt.Sprintf("/maintenances/%d", id)
+	if err := c.Get(ctx, path, &result); err != nil {
+		return nil, fmt.Errorf("failed to get maintenance %d: %w", id, err)
+	}
+	return &result.Maintenance, nil
+}
+
+// CreateMaintenance creates a new maintenance window and sets its ID.
+func (c *Client) CreateMaintenance(ctx context.Context, maintenance *Maintenance) (*Maintenance, error) {
+	data, err := json.Marshal(maintenance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal maintenance: %w", err)
+	}
+
+	var apiResponse createMaintenanceAPIResponse
+	if err := c.Post(ctx, "/maintenances", bytes.NewReader(data), &apiResponse); err != nil {
+		return nil, fmt.Errorf("failed to create maintenance: %w", err)
+	}
+
+	if apiResponse.MaintenanceID <= 0 {
+		return nil, fmt.Errorf("API did not return valid maintenanceID: %d", apiResponse.MaintenanceID)
+	}
+
+	maintenance.ID = apiResponse.MaintenanceID
+	return maintenance, nil
+}
+
+// UpdateMaintenance updates an existing maintenance window. Fields omitted from the
+// request are left unchanged, so fields to clear must be listed in NullFields.
+func (c *Client) UpdateMaintenance(ctx context.Context, id int, maintenance *Maintenance) error {
+	data, err := json.Marshal(maintenance)
+	if err != nil {
+		return fmt.Errorf("failed to marshal maintenance: %w", err)
+	}
+
+	path := fmt.Sprintf("/maintenances/%d", id)
+	if err := c.Patch(ctx, path, bytes.NewReader(data), nil); err != nil {
+		return fmt.Errorf("failed to update maintenance %d: %w", id, err)
+	}
+	return nil
+}
+
+// DeleteMaintenance deletes a maintenance window.
+func (c *Client) DeleteMaintenance(ctx context.Context, id int) error {
+	path := fmt.Sprintf("/maintenances/%d", id)
+	if err := c.Delete(ctx, path, nil); err != nil {
+		return fmt.Errorf("failed to delete maintenance %d: %w", id, err)
+	}
+	return nil
+}
+
+// PauseMaintenance pauses a maintenance window.
+func (c *Client) PauseMaintenance(ctx context.Context, id int) error {
+	path := fmt.Sprintf("/maintenances/%d/pause", id)
+	if err := c.Post(ctx, path, nil, nil); err != nil {
+		return fmt.Errorf("failed to pause maintenance %d: %w", id, err)
+	}
+	return nil
+}
+
+// ResumeMaintenance resumes a paused maintenance window.
+func (c *Client) ResumeMaintenance(ctx context.Context, id int) error {
+	path := fmt.Sprintf("/maintenances/%d/resume", id)
+	if err := c.Post(ctx, path, nil, nil); err != nil {
+		return fmt.Errorf("failed to resume maintenance %d: %w", id, err)
+	}
+	return nil
+}
+
+// GetMaintenanceMonitors retrieves the monitors affected by a maintenance window.
+func (c *Client) GetMaintenanceMonitors(ctx context.Context, id int) ([]MaintenanceMonitor, error) {
+	var result maintenanceMonitorsAPIResponse
+	path := fmt.Sprintf("/maintenances/%d/monitors", id)
+	if err := c.Get(ctx, path, &result); err != nil {
+		return nil, fmt.Errorf("failed to get monitors of maintenance %d: %w", id, err)
+	}
+	return result.Monitors, nil
+}
+
+// SetMaintenanceMonitors replaces the monitors affected by a maintenance window.
+func (c *Client) SetMaintenanceMonitors(ctx context.Context, id int, monitors []MaintenanceMonitor) error {
+	if monitors == nil {
+		monitors = []MaintenanceMonitor{}
+	}
+
+	data, err := json.Marshal(monitors)
+	if err != nil {
+		return fmt.Errorf("failed to marshal maintenance monitors: %w", err)
+	}
+
+	path := fmt.Sprintf("/maintenances/%d/monitors", id)
+	if err := c.Post(ctx, path, bytes.NewReader(data), nil); err != nil {
+		return fmt.Errorf("failed to set monitors of maintenance %d: %w", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// maintenanceServer is an in-memory implementation of the /maintenances endpoints.
+type maintenanceServer struct {
+	mu           sync.Mutex
+	nextID       int
+	maintenances map[int]*Maintenance
+	monitors     map[int][]MaintenanceMonitor
+}
+
+func newMaintenanceServer() *maintenanceServer {
+	return &maintenanceServer{
+		nextID:       1,
+		maintenances: map[int]*Maintenance{},
+		monitors:     map[int][]MaintenanceMonitor{},
+	}
+}
+
+func (s *maintenanceServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if r.URL.Path == "/login/access-token" {
+		_ = json.NewEncoder(w).Encode(TokenResponse{AccessToken: "test-token-12345", TokenType: "Bearer"})
+		return
+	}
+
+	if r.Header.Get("Authorization") != "Bearer test-token-12345" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.URL.Path == "/maintenances" {
+		switch r.Method {
+		case http.MethodGet:
+			list := make([]Maintenance, 0, len(s.maintenances))
+			for _, m := range s.maintenances {
+				list = append(list, *m)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"maintenances": list})
+		case http.MethodPost:
+			var m Maintenance
+			if err := json.NewDecoder(r.Body).Decode(&m); err != nil {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				return
+			}
+			m.ID = s.nextID
+			m.Active = true
+			s.nextID++
+			s.maintenances[m.ID] = &m
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"msg": "Added Successfully.", "maintenanceID": m.ID})
+		}
+		return
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/maintenances/"), "/")
+	id, err := strconv.Atoi(parts[0])
+	m, ok := s.maintenances[id]
+	if err != nil || !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"detail":"Maintenance not found"}`))
+		return
+	}
+
+	action := ""
+	if len(parts) > 1 {
+		action = parts[1]
+	}
+
+	switch {
+	case action == "" && r.Method == http.MethodGet:
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"maintenance": m})
+	case action == "" && r.Method == http.MethodPatch:
+		var update Maintenance
+		if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		update.ID = id
+		update.Active = m.Active
+		s.maintenances[id] = &update
+		_, _ = w.Write([]byte(`{"msg":"Saved."}`))
+	case action == "" && r.Method == http.MethodDelete:
+		delete(s.maintenances, id)
+		_, _ = w.Write([]byte(`{"msg":"Deleted Successfully."}`))
+	case action == "pause":
+		m.Active = false
+		_, _ = w.Write([]byte(`{"msg":"Paused Successfully."}`))
+	case action == "resume":
+		m.Active = true
+		_, _ = w.Write([]byte(`{"msg":"Resume Successfully"}`))
+	case action == "monitors" && r.Method == http.MethodGet:
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"monitors": s.monitors[id]})
+	case action == "monitors" && r.Method == http.MethodPost:
+		var monitors []MaintenanceMonitor
+		if err := json.NewDecoder(r.Body).Decode(&monitors); err != nil {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		s.monitors[id] = monitors
+		_, _ = w.Write([]byte(`{"msg":"Added Successfully."}`))
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// TestMaintenanceOperations tests maintenance API operations.
+func TestMaintenanceOperations(t *testing.T) {
+	server := httptest.NewServer(newMaintenanceServer())
+	defer server.Close()
+
+	client, err := New(&Config{
+		BaseURL:  server.URL,
+		Username: "testuser",
+		Password: "testpass",
+		Timeout:  5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx := context.Background()
+
+	created, err := client.CreateMaintenance(ctx, &Maintenance{
+		Title:       "Patch window",
+		Strategy:    MaintenanceStrategyRecurringWeekday,
+		Active:      true,
+		Weekdays:    []int{0, 6},
+		DaysOfMonth: []interface{}{},
+		TimeRange:   []MaintenanceTime{{Hours: 2}, {Hours: 3, Minutes: 30}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create maintenance: %v", err)
+	}
+	if created.ID != 1 {
+		t.Fatalf("Expected maintenance ID 1, got %d", created.ID)
+	}
+
+	maintenance, err := client.GetMaintenance(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("Failed to get maintenance: %v", err)
+	}
+	if maintenance.Title != "Patch window" || maintenance.Strategy != MaintenanceStrategyRecurringWeekday {
+		t.Errorf("Unexpected maintenance: %+v", maintenance)
+	}
+	if len(maintenance.TimeRange) != 2 || maintenance.TimeRange[1].Minutes != 30 {
+		t.Errorf("Unexpected time range: %+v", maintenance.TimeRange)
+	}
+
+	maintenance.Title = "Updated window"
+	if err := client.UpdateMaintenance(ctx, created.ID, maintenance); err != nil {
+		t.Fatalf("Failed to update maintenance: %v", err)
+	}
+
+	maintenances, err := client.GetMaintenances(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get maintenances: %v", err)
+	}
+	if len(maintenances) != 1 || maintenances[0].Title != "Updated window" {
+		t.Errorf("Unexpected maintenances: %+v", maintenances)
+	}
+
+	if err := client.PauseMaintenance(ctx, created.ID); err != nil {
+		t.Fatalf("Failed to pause maintenance: %v", err)
+	}
+	if maintenance, err = client.GetMaintenance(ctx, created.ID); err != nil || maintenance.Active {
+		t.Errorf("Expected paused maintenance, got %+v (%v)", maintenance, err)
+	}
+
+	if err := client.ResumeMaintenance(ctx, created.ID); err != nil {
+		t.Fatalf("Failed to resume maintenance: %v", err)
+	}
+	if maintenance, err = client.GetMaintenance(ctx, created.ID); err != nil || !maintenance.Active {
+		t.Errorf("Expected active maintenance, got %+v (%v)", maintenance, err)
+	}
+
+	if err := client.SetMaintenanceMonitors(ctx, created.ID, []MaintenanceMonitor{{ID: 1}, {ID: 2}}); err != nil {
+		t.Fatalf("Failed to set maintenance monitors: %v", err)
+	}
+	monitors, err := client.GetMaintenanceMonitors(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("Failed to get maintenance monitors: %v", err)
+	}
+	if len(monitors) != 2 || monitors[0].ID != 1 || monitors[1].ID != 2 {
+		t.Errorf("Unexpected maintenance monitors: %+v", monitors)
+	}
+
+	if err := client.DeleteMaintenance(ctx, created.ID); err != nil {
+		t.Fatalf("Failed to delete maintenance: %v", err)
+	}
+	if _, err := client.GetMaintenance(ctx, created.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound after delete, got %v", err)
+	}
+}
+
+// TestMaintenanceNullFields tests that fields listed in NullFields are sent as null.
+func TestMaintenanceNullFields(t *testing.T) {
+	data, err := json.Marshal(&Maintenance{
+		Title:      "Patch window",
+		Strategy:   MaintenanceStrategyCron,
+		Cron:       "0 3 * * *",
+		NullFields: []string{"dateRange", "timezoneOption"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to marshal maintenance: %v", err)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatalf("Failed to unmarshal maintenance: %v", err)
+	}
+	for _, name := range []string{"dateRange", "timezoneOption"} {
+		if value, ok := fields[name]; !ok || string(value) != "null" {
+			t.Errorf("Expected %s to be sent as null, got %s", name, value)
+		}
+	}
+	if string(fields["cron"]) != `"0 3 * * *"` {
+		t.Errorf("Expected cron to be sent unchanged, got %s", fields["cron"])
+	}
+	if _, ok := fields["NullFields"]; ok {
+		t.Error("Expected NullFields not to be sent")
+	}
+}
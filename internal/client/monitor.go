@@ -8,6 +8,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"sort"
 	"strconv"
 )
 
@@ -16,23 +18,27 @@ type MonitorType string
 
 // Monitor types.
 const (
-	MonitorTypeHTTP      MonitorType = "http"
-	MonitorTypePing      MonitorType = "ping"
-	MonitorTypePort      MonitorType = "port"
-	MonitorTypeDNS       MonitorType = "dns"
-	MonitorTypeKeyword   MonitorType = "keyword"
-	MonitorTypeGRPC      MonitorType = "grpc-keyword"
-	MonitorTypeDocker    MonitorType = "docker"
-	MonitorTypePush      MonitorType = "push"
-	MonitorTypeSteam     MonitorType = "steam"
-	MonitorTypeGamedig   MonitorType = "gamedig"
-	MonitorTypeMQTT      MonitorType = "mqtt"
-	MonitorTypeSQLServer MonitorType = "sqlserver"
-	MonitorTypePostgres  MonitorType = "postgres"
-	MonitorTypeMySQL     MonitorType = "mysql"
-	MonitorTypeMongoDB   MonitorType = "mongodb"
-	MonitorTypeRadius    MonitorType = "radius"
-	MonitorTypeRedis     MonitorType = "redis"
+	MonitorTypeGroup         MonitorType = "group"
+	MonitorTypeHTTP          MonitorType = "http"
+	MonitorTypePing          MonitorType = "ping"
+	MonitorTypePort          MonitorType = "port"
+	MonitorTypeDNS           MonitorType = "dns"
+	MonitorTypeKeyword       MonitorType = "keyword"
+	MonitorTypeJSONQuery     MonitorType = "json-query"
+	MonitorTypeGRPC          MonitorType = "grpc-keyword"
+	MonitorTypeDocker        MonitorType = "docker"
+	MonitorTypePush          MonitorType = "push"
+	MonitorTypeSteam         MonitorType = "steam"
+	MonitorTypeGamedig       MonitorType = "gamedig"
+	MonitorTypeMQTT          MonitorType = "mqtt"
+	MonitorTypeKafkaProducer MonitorType = "kafka-producer"
+	MonitorTypeSQLServer     MonitorType = "sqlserver"
+	MonitorTypePostgres      MonitorType = "postgres"
+	MonitorTypeMySQL         MonitorType = "mysql"
+	MonitorTypeMongoDB       MonitorType = "mongodb"
+	MonitorTypeRadius        MonitorType = "radius"
+	MonitorTypeRedis         MonitorType = "redis"
+	MonitorTypeTailscalePing MonitorType = "tailscale-ping"
 )
 
 // AuthMethod represents the authentication method for monitors.
@@ -40,45 +46,163 @@ type AuthMethod string
 
 // Auth methods.
 const (
-	AuthMethodNone  AuthMethod = ""
-	AuthMethodBasic AuthMethod = "basic"
-	AuthMethodNTLM  AuthMethod = "ntlm"
-	AuthMethodMTLS  AuthMethod = "mtls"
+	AuthMethodNone     AuthMethod = ""
+	AuthMethodBasic    AuthMethod = "basic"
+	AuthMethodNTLM     AuthMethod = "ntlm"
+	AuthMethodMTLS     AuthMethod = "mtls"
+	AuthMethodOAuth2CC AuthMethod = "oauth2-cc"
 )
 
+// MonitorTag is a tag attached to a monitor.
+type MonitorTag struct {
+	ID        int    `json:"id,omitempty"`
+	MonitorID int    `json:"monitor_id,omitempty"`
+	TagID     int    `json:"tag_id"`
+	Value     string `json:"value"`
+	Name      string `json:"name,omitempty"`
+	Color     string `json:"color,omitempty"`
+}
+
+// KafkaProducerSASLOptions holds the SASL authentication options of a kafka-producer monitor.
+type KafkaProducerSASLOptions struct {
+	Mechanism string `json:"mechanism"`
+	Username  string `json:"username,omitempty"`
+	Password  string `json:"password,omitempty"`
+}
+
+// IntBool is a boolean the API encodes either as true/false or as 1/0.
+type IntBool bool
+
+// UnmarshalJSON accepts JSON booleans, 1/0 and null.
+func (b *IntBool) UnmarshalJSON(data []byte) error {
+	switch string(data) {
+	case "true", "1":
+		*b = true
+	case "false", "0", "null":
+		*b = false
+	default:
+		return fmt.Errorf("invalid boolean value: %s", data)
+	}
+	return nil
+}
+
+// NotificationIDList is the list of notification IDs attached to a monitor.
+// Monitors are returned with an object mapping each ID to whether it is
+// enabled, while create and update requests send a plain array.
+type NotificationIDList []int
+
+// UnmarshalJSON accepts either an array of IDs or an object of ID to enabled flag.
+func (l *NotificationIDList) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || trimmed[0] != '{' {
+		var ids []int
+		if err := json.Unmarshal(data, &ids); err != nil {
+			return fmt.Errorf("invalid notification ID list: %w", err)
+		}
+		*l = ids
+		return nil
+	}
+
+	var enabled map[string]IntBool
+	if err := json.Unmarshal(data, &enabled); err != nil {
+		return fmt.Errorf("invalid notification ID list: %w", err)
+	}
+	ids := make([]int, 0, len(enabled))
+	for key, on := range enabled {
+		if !on {
+			continue
+		}
+		id, err := strconv.Atoi(key)
+		if err != nil {
+			return fmt.Errorf("invalid notification ID %q: %w", key, err)
+		}
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	*l = ids
+	return nil
+}
+
 // Monitor represents an Uptime Kuma monitor.
 type Monitor struct {
-	ID                  int           `json:"id,omitempty"`
-	Type                MonitorType   `json:"type"`
-	Name                string        `json:"name"`
-	Description         string        `json:"description"`
-	URL                 string        `json:"url,omitempty"`
-	Method              string        `json:"method,omitempty"`
-	Hostname            string        `json:"hostname,omitempty"`
-	Port                int           `json:"port,omitempty"`
-	Interval            int           `json:"interval"`
-	RetryInterval       int           `json:"retryInterval"`
-	ResendInterval      int           `json:"resendInterval"`
-	MaxRetries          int           `json:"maxretries"`
-	UpsideDown          bool          `json:"upsideDown"`
-	NotificationIDList  []interface{} `json:"notificationIDList"`
-	ExpiryNotification  bool          `json:"expiryNotification"`
-	IgnoreTLS           bool          `json:"ignoreTls"`
-	MaxRedirects        int           `json:"maxredirects,omitempty"`
-	AcceptedStatusCodes []interface{} `json:"accepted_statuscodes,omitempty"`
-	ProxyID             int           `json:"proxyId,omitempty"`
-	Body                string        `json:"body,omitempty"`
-	Headers             string        `json:"headers,omitempty"`
-	AuthMethod          AuthMethod    `json:"authMethod,omitempty"`
-	BasicAuthUser       string        `json:"basic_auth_user,omitempty"`
-	BasicAuthPass       string        `json:"basic_auth_pass,omitempty"`
-	AuthDomain          string        `json:"authDomain,omitempty"`
-	AuthWorkstation     string        `json:"authWorkstation,omitempty"`
-	Keyword             string        `json:"keyword,omitempty"`
-	DNSResolveServer    string        `json:"dns_resolve_server,omitempty"`
-	DNSResolveType      string        `json:"dns_resolve_type,omitempty"`
-	DockerContainer     string        `json:"docker_container,omitempty"`
-	DockerHost          int           `json:"docker_host,omitempty"`
+	ID                                  int                       `json:"id,omitempty"`
+	Type                                MonitorType               `json:"type"`
+	Name                                string                    `json:"name"`
+	Parent                              *int                      `json:"parent"`
+	Active                              IntBool                   `json:"active,omitempty"`
+	Description                         string                    `json:"description"`
+	URL                                 string                    `json:"url,omitempty"`
+	Method                              string                    `json:"method,omitempty"`
+	Hostname                            string                    `json:"hostname,omitempty"`
+	Port                                int                       `json:"port,omitempty"`
+	Interval                            int                       `json:"interval"`
+	RetryInterval                       int                       `json:"retryInterval"`
+	ResendInterval                      int                       `json:"resendInterval"`
+	MaxRetries                          int                       `json:"maxretries"`
+	UpsideDown                          bool                      `json:"upsideDown"`
+	NotificationIDList                  NotificationIDList        `json:"notificationIDList"`
+	ExpiryNotification                  bool                      `json:"expiryNotification"`
+	IgnoreTLS                           bool                      `json:"ignoreTls"`
+	MaxRedirects                        int                       `json:"maxredirects"`
+	AcceptedStatusCodes                 []string                  `json:"accepted_statuscodes,omitempty"`
+	ProxyID                             int                       `json:"proxyId,omitempty"`
+	HTTPBodyEncoding                    string                    `json:"httpBodyEncoding,omitempty"`
+	Timeout                             int                       `json:"timeout,omitempty"`
+	Body                                string                    `json:"body,omitempty"`
+	Headers                             string                    `json:"headers,omitempty"`
+	AuthMethod                          AuthMethod                `json:"authMethod,omitempty"`
+	BasicAuthUser                       string                    `json:"basic_auth_user,omitempty"`
+	BasicAuthPass                       string                    `json:"basic_auth_pass,omitempty"`
+	AuthDomain                          string                    `json:"authDomain,omitempty"`
+	AuthWorkstation                     string                    `json:"authWorkstation,omitempty"`
+	TLSCert                             string                    `json:"tlsCert,omitempty"`
+	TLSKey                              string                    `json:"tlsKey,omitempty"`
+	TLSCa                               string                    `json:"tlsCa,omitempty"`
+	OAuthAuthMethod                     string                    `json:"oauth_auth_method,omitempty"`
+	OAuthTokenURL                       string                    `json:"oauth_token_url,omitempty"`
+	OAuthClientID                       string                    `json:"oauth_client_id,omitempty"`
+	OAuthClientSecret                   string                    `json:"oauth_client_secret,omitempty"`
+	OAuthScopes                         string                    `json:"oauth_scopes,omitempty"`
+	DatabaseConnectionString            string                    `json:"databaseConnectionString,omitempty"`
+	DatabaseQuery                       string                    `json:"databaseQuery,omitempty"`
+	MQTTUsername                        string                    `json:"mqttUsername,omitempty"`
+	MQTTPassword                        string                    `json:"mqttPassword,omitempty"`
+	MQTTTopic                           string                    `json:"mqttTopic,omitempty"`
+	MQTTSuccessMessage                  string                    `json:"mqttSuccessMessage,omitempty"`
+	KafkaProducerBrokers                []string                  `json:"kafkaProducerBrokers,omitempty"`
+	KafkaProducerTopic                  string                    `json:"kafkaProducerTopic,omitempty"`
+	KafkaProducerMessage                string                    `json:"kafkaProducerMessage,omitempty"`
+	KafkaProducerSSL                    bool                      `json:"kafkaProducerSsl"`
+	KafkaProducerAllowAutoTopicCreation bool                      `json:"kafkaProducerAllowAutoTopicCreation"`
+	KafkaProducerSASLOptions            *KafkaProducerSASLOptions `json:"kafkaProducerSaslOptions,omitempty"`
+	Keyword                             string                    `json:"keyword,omitempty"`
+	InvertKeyword                       bool                      `json:"invertKeyword"`
+	JSONPath                            string                    `json:"jsonPath,omitempty"`
+	ExpectedValue                       string                    `json:"expectedValue,omitempty"`
+	DNSResolveServer                    string                    `json:"dns_resolve_server,omitempty"`
+	DNSResolveType                      string                    `json:"dns_resolve_type,omitempty"`
+	PacketSize                          int                       `json:"packetSize,omitempty"`
+	RadiusUsername                      string                    `json:"radiusUsername,omitempty"`
+	RadiusPassword                      string                    `json:"radiusPassword,omitempty"`
+	RadiusSecret                        string                    `json:"radiusSecret,omitempty"`
+	RadiusCalledStationID               string                    `json:"radiusCalledStationId,omitempty"`
+	RadiusCallingStationID              string                    `json:"radiusCallingStationId,omitempty"`
+	Game                                string                    `json:"game,omitempty"`
+	GamedigGivenPortOnly                bool                      `json:"gamedigGivenPortOnly"`
+	DockerContainer                     string                    `json:"docker_container,omitempty"`
+	DockerHost                          int                       `json:"docker_host,omitempty"`
+	PushToken                           string                    `json:"pushToken,omitempty"`
+	Tags                                []MonitorTag              `json:"tags,omitempty"`
+
+	// NullFields lists JSON field names sent as null, so an update clears them
+	// instead of omitting them.
+	NullFields []string `json:"-"`
+}
+
+// MarshalJSON encodes the monitor, sending the fields listed in NullFields as null.
+func (m Monitor) MarshalJSON() ([]byte, error) {
+	type monitor Monitor
+	return marshalWithNullFields(monitor(m), m.NullFields)
 }
 
 // GetMonitors retrieves all monitors.
@@ -129,7 +253,8 @@ func (c *Client) CreateMonitor(ctx context.Context, monitor *Monitor) (*Monitor,
 	return monitor, nil
 }
 
-// UpdateMonitor updates an existing monitor.
+// UpdateMonitor updates an existing monitor. Fields omitted from the request are
+// left unchanged, so fields to clear must be listed in NullFields.
 func (c *Client) UpdateMonitor(ctx context.Context, id int, monitor *Monitor) (*Monitor, error) {
 	data, err := json.Marshal(monitor)
 	if err != nil {
@@ -153,6 +278,25 @@ func (c *Client) DeleteMonitor(ctx context.Context, id int) error {
 	return nil
 }
 
+// SetMonitorParent moves a monitor into the group with the given ID, or out of
+// any group when parentID is nil.
+func (c *Client) SetMonitorParent(ctx context.Context, id int, parentID *int) error {
+	data, err := json.Marshal(struct {
+		Parent *int `json:"parent"`
+	}{
+		Parent: parentID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal monitor parent: %w", err)
+	}
+
+	path := fmt.Sprintf("/monitors/%d", id)
+	if err := c.Patch(ctx, path, bytes.NewReader(data), nil); err != nil {
+		return fmt.Errorf("failed to set parent of monitor %d: %w", id, err)
+	}
+	return nil
+}
+
 // PauseMonitor pauses a monitor.
 func (c *Client) PauseMonitor(ctx context.Context, id int) error {
 	path := fmt.Sprintf("/monitors/%d/pause", id)
@@ -203,12 +347,14 @@ func (c *Client) AddMonitorTag(ctx context.Context, monitorID int, tagID int, va
 	return nil
 }
 
-// DeleteMonitorTag removes a tag from a monitor.
-func (c *Client) DeleteMonitorTag(ctx context.Context, monitorID int, tagID int) error {
+// DeleteMonitorTag removes a tag with the given value from a monitor.
+func (c *Client) DeleteMonitorTag(ctx context.Context, monitorID int, tagID int, value string) error {
 	tag := struct {
-		TagID int `json:"tag_id"`
+		TagID int    `json:"tag_id"`
+		Value string `json:"value"`
 	}{
 		TagID: tagID,
+		Value: value,
 	}
 
 	data, err := json.Marshal(tag)
@@ -216,8 +362,9 @@ func (c *Client) DeleteMonitorTag(ctx context.Context, monitorID int, tagID int)
 		return fmt.Errorf("failed to marshal tag: %w", err)
 	}
 
+	// The tag to delete is identified by the request body, which Delete does not send.
 	path := fmt.Sprintf("/monitors/%d/tag", monitorID)
-	if err := c.Delete(ctx, path, bytes.NewReader(data)); err != nil {
+	if err := c.doRequest(ctx, http.MethodDelete, path, bytes.NewReader(data), nil); err != nil {
 		return fmt.Errorf("failed to delete tag %d from monitor %d: %w", tagID, monitorID, err)
 	}
 	return nil
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -87,7 +88,10 @@ func TestMonitorOperations(t *testing.T) {
 				newMonitor.ID = len(monitors) + 1
 				monitors = append(monitors, newMonitor)
 				w.WriteHeader(http.StatusOK)
-				_ = json.NewEncoder(w).Encode(newMonitor)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"msg":       "Added Successfully.",
+					"monitorID": newMonitor.ID,
+				})
 				return
 			}
 		} else if strings.HasPrefix(r.URL.Path, "/monitors/") {
@@ -328,7 +332,7 @@ func TestMonitorOperations(t *testing.T) {
 		}
 
 		// Test DeleteMonitorTag
-		if err := client.DeleteMonitorTag(ctx, 1, 99); err != nil {
+		if err := client.DeleteMonitorTag(ctx, 1, 99, "test"); err != nil {
 			t.Fatalf("DeleteMonitorTag failed: %v", err)
 		}
 	*/
@@ -338,3 +342,393 @@ func TestMonitorOperations(t *testing.T) {
 		t.Fatalf("DeleteMonitor failed: %v", err)
 	}
 }
+
+// TestMonitorTagOperations tests attaching and removing monitor tags.
+func TestMonitorTagOperations(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/login/access-token" {
+			_ = json.NewEncoder(w).Encode(TokenResponse{AccessToken: "test-token-12345", TokenType: "Bearer"})
+			return
+		}
+
+		switch {
+		case r.URL.Path == "/monitors/1" && r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`{"id":1,"type":"http","name":"Tagged","tags":[{"id":7,"monitor_id":1,"tag_id":3,"value":"prod","name":"env","color":"#059669"}]}`))
+		case r.URL.Path == "/monitors/1/tag":
+			body, _ := io.ReadAll(r.Body)
+			requests = append(requests, r.Method+" "+string(body))
+			_, _ = w.Write([]byte(`{"msg":"Successful."}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(&Config{
+		BaseURL:  server.URL,
+		Username: "testuser",
+		Password: "testpass",
+		Timeout:  5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx := context.Background()
+
+	monitor, err := client.GetMonitor(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetMonitor failed: %v", err)
+	}
+	if len(monitor.Tags) != 1 || monitor.Tags[0].TagID != 3 || monitor.Tags[0].Value != "prod" {
+		t.Errorf("Unexpected monitor tags: %+v", monitor.Tags)
+	}
+
+	if err := client.AddMonitorTag(ctx, 1, 3, "prod"); err != nil {
+		t.Fatalf("AddMonitorTag failed: %v", err)
+	}
+	if err := client.DeleteMonitorTag(ctx, 1, 3, "prod"); err != nil {
+		t.Fatalf("DeleteMonitorTag failed: %v", err)
+	}
+
+	expected := []string{
+		`POST {"tag_id":3,"value":"prod"}`,
+		`DELETE {"tag_id":3,"value":"prod"}`,
+	}
+	if len(requests) != len(expected) {
+		t.Fatalf("Expected requests %v, got %v", expected, requests)
+	}
+	for i := range expected {
+		if requests[i] != expected[i] {
+			t.Errorf("Request %d: expected %s, got %s", i, expected[i], requests[i])
+		}
+	}
+}
+
+// TestMonitorHTTPFields tests that HTTP monitor options round-trip through the API.
+func TestMonitorHTTPFields(t *testing.T) {
+	var created map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/login/access-token" {
+			_ = json.NewEncoder(w).Encode(TokenResponse{AccessToken: "test-token-12345", TokenType: "Bearer"})
+			return
+		}
+
+		switch {
+		case r.URL.Path == "/monitors" && r.Method == http.MethodPost:
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			_, _ = w.Write([]byte(`{"msg":"Added Successfully.","monitorID":1}`))
+		case r.URL.Path == "/monitors/1" && r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`{"id":1,"type":"http","name":"API","accepted_statuscodes":["200-299","401"],"httpBodyEncoding":"xml","timeout":30,"proxyId":2,"expiryNotification":true,"invertKeyword":true,"maxredirects":0}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(&Config{
+		BaseURL:  server.URL,
+		Username: "testuser",
+		Password: "testpass",
+		Timeout:  5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx := context.Background()
+
+	_, err = client.CreateMonitor(ctx, &Monitor{
+		Type:                MonitorTypeHTTP,
+		Name:                "API",
+		URL:                 "https://api.example.com",
+		AcceptedStatusCodes: []string{"200-299", "401"},
+		HTTPBodyEncoding:    "xml",
+		Timeout:             30,
+		ProxyID:             2,
+		ExpiryNotification:  true,
+		InvertKeyword:       true,
+		MaxRedirects:        0,
+	})
+	if err != nil {
+		t.Fatalf("CreateMonitor failed: %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"accepted_statuscodes": []interface{}{"200-299", "401"},
+		"httpBodyEncoding":     "xml",
+		"timeout":              float64(30),
+		"proxyId":              float64(2),
+		"expiryNotification":   true,
+		"invertKeyword":        true,
+		"maxredirects":         float64(0),
+	}
+	for key, value := range expected {
+		if !reflect.DeepEqual(created[key], value) {
+			t.Errorf("Expected %s to be sent as %v, got %v", key, value, created[key])
+		}
+	}
+
+	monitor, err := client.GetMonitor(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetMonitor failed: %v", err)
+	}
+	if !reflect.DeepEqual(monitor.AcceptedStatusCodes, []string{"200-299", "401"}) {
+		t.Errorf("Unexpected accepted status codes: %v", monitor.AcceptedStatusCodes)
+	}
+	if monitor.HTTPBodyEncoding != "xml" || monitor.Timeout != 30 || monitor.ProxyID != 2 {
+		t.Errorf("Unexpected HTTP options: %+v", monitor)
+	}
+	if !monitor.ExpiryNotification || !monitor.InvertKeyword || monitor.MaxRedirects != 0 {
+		t.Errorf("Unexpected HTTP flags: %+v", monitor)
+	}
+}
+
+// TestMonitorKafkaProducerFields tests that kafka-producer options are sent in the API format.
+func TestMonitorKafkaProducerFields(t *testing.T) {
+	monitor := Monitor{
+		Type:                 MonitorTypeKafkaProducer,
+		Name:                 "Events",
+		KafkaProducerBrokers: []string{"kafka-1:9092", "kafka-2:9092"},
+		KafkaProducerTopic:   "uptime",
+		KafkaProducerMessage: "ping",
+		KafkaProducerSSL:     true,
+		KafkaProducerSASLOptions: &KafkaProducerSASLOptions{
+			Mechanism: "scram-sha-512",
+			Username:  "uptime",
+			Password:  "secret",
+		},
+	}
+
+	data, err := json.Marshal(monitor)
+	if err != nil {
+		t.Fatalf("Failed to marshal monitor: %v", err)
+	}
+
+	var sent map[string]interface{}
+	if err := json.Unmarshal(data, &sent); err != nil {
+		t.Fatalf("Failed to unmarshal monitor: %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"kafkaProducerBrokers":                []interface{}{"kafka-1:9092", "kafka-2:9092"},
+		"kafkaProducerTopic":                  "uptime",
+		"kafkaProducerMessage":                "ping",
+		"kafkaProducerSsl":                    true,
+		"kafkaProducerAllowAutoTopicCreation": false,
+		"kafkaProducerSaslOptions": map[string]interface{}{
+			"mechanism": "scram-sha-512",
+			"username":  "uptime",
+			"password":  "secret",
+		},
+	}
+	for key, value := range expected {
+		if !reflect.DeepEqual(sent[key], value) {
+			t.Errorf("Expected %s to be sent as %v, got %v", key, value, sent[key])
+		}
+	}
+	if _, ok := sent["mqttTopic"]; ok {
+		t.Errorf("Expected unset mqttTopic to be omitted")
+	}
+}
+
+// TestMonitorNetworkFields tests that DNS, ping, RADIUS and GameDig options are decoded from the API.
+func TestMonitorNetworkFields(t *testing.T) {
+	data := `{"id":3,"type":"radius","name":"RADIUS","hostname":"radius.example.com","port":1812,` +
+		`"dns_resolve_server":"9.9.9.9","dns_resolve_type":"MX","packetSize":64,` +
+		`"radiusUsername":"probe","radiusCalledStationId":"00-11-22","radiusCallingStationId":"33-44-55",` +
+		`"game":"minecraft","gamedigGivenPortOnly":true}`
+
+	var monitor Monitor
+	if err := json.Unmarshal([]byte(data), &monitor); err != nil {
+		t.Fatalf("Failed to unmarshal monitor: %v", err)
+	}
+
+	expected := Monitor{
+		ID:                     3,
+		Type:                   MonitorTypeRadius,
+		Name:                   "RADIUS",
+		Hostname:               "radius.example.com",
+		Port:                   1812,
+		DNSResolveServer:       "9.9.9.9",
+		DNSResolveType:         "MX",
+		PacketSize:             64,
+		RadiusUsername:         "probe",
+		RadiusCalledStationID:  "00-11-22",
+		RadiusCallingStationID: "33-44-55",
+		Game:                   "minecraft",
+		GamedigGivenPortOnly:   true,
+	}
+	if !reflect.DeepEqual(monitor, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, monitor)
+	}
+}
+
+// TestSetMonitorParent tests moving a monitor into and out of a group.
+func TestSetMonitorParent(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/login/access-token" {
+			_ = json.NewEncoder(w).Encode(TokenResponse{AccessToken: "test-token-12345", TokenType: "Bearer"})
+			return
+		}
+
+		if r.URL.Path == "/monitors/2" && r.Method == http.MethodPatch {
+			body, _ := io.ReadAll(r.Body)
+			requests = append(requests, string(body))
+			_, _ = w.Write([]byte(`{"msg":"Saved."}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client, err := New(&Config{
+		BaseURL:  server.URL,
+		Username: "testuser",
+		Password: "testpass",
+		Timeout:  5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx := context.Background()
+	groupID := 1
+
+	if err := client.SetMonitorParent(ctx, 2, &groupID); err != nil {
+		t.Fatalf("SetMonitorParent failed: %v", err)
+	}
+	if err := client.SetMonitorParent(ctx, 2, nil); err != nil {
+		t.Fatalf("SetMonitorParent failed: %v", err)
+	}
+
+	expected := []string{`{"parent":1}`, `{"parent":null}`}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("Expected requests %v, got %v", expected, requests)
+	}
+}
+
+// TestMonitorActive tests decoding the active flag in both encodings used by the API.
+func TestMonitorActive(t *testing.T) {
+	for data, expected := range map[string]IntBool{
+		`{"id":1,"active":true}`:  true,
+		`{"id":1,"active":1}`:     true,
+		`{"id":1,"active":false}`: false,
+		`{"id":1,"active":0}`:     false,
+		`{"id":1,"active":null}`:  false,
+	} {
+		var monitor Monitor
+		if err := json.Unmarshal([]byte(data), &monitor); err != nil {
+			t.Fatalf("Failed to unmarshal %s: %v", data, err)
+		}
+		if monitor.Active != expected {
+			t.Errorf("Expected active %t for %s, got %t", expected, data, monitor.Active)
+		}
+	}
+
+	var monitor Monitor
+	if err := json.Unmarshal([]byte(`{"id":1,"active":"yes"}`), &monitor); err == nil {
+		t.Error("Expected an error for an invalid active value")
+	}
+}
+
+// TestMonitorNotificationIDList tests decoding notification IDs in both shapes used by the API.
+func TestMonitorNotificationIDList(t *testing.T) {
+	for data, expected := range map[string]NotificationIDList{
+		`{"id":1,"notificationIDList":[3,1]}`:                      {3, 1},
+		`{"id":1,"notificationIDList":{"1":true}}`:                 {1},
+		`{"id":1,"notificationIDList":{"4":true,"2":1,"3":false}}`: {2, 4},
+		`{"id":1,"notificationIDList":{}}`:                         {},
+		`{"id":1,"notificationIDList":null}`:                       nil,
+	} {
+		var monitor Monitor
+		if err := json.Unmarshal([]byte(data), &monitor); err != nil {
+			t.Fatalf("Failed to unmarshal %s: %v", data, err)
+		}
+		if !reflect.DeepEqual(monitor.NotificationIDList, expected) {
+			t.Errorf("Expected notification IDs %v for %s, got %v", expected, data, monitor.NotificationIDList)
+		}
+	}
+
+	var monitor Monitor
+	if err := json.Unmarshal([]byte(`{"id":1,"notificationIDList":{"email":true}}`), &monitor); err == nil {
+		t.Error("Expected an error for a non-numeric notification ID")
+	}
+
+	encoded, err := json.Marshal(Monitor{Type: MonitorTypeHTTP, Name: "HTTP", NotificationIDList: NotificationIDList{1, 2}})
+	if err != nil {
+		t.Fatalf("Failed to marshal monitor: %v", err)
+	}
+	if !strings.Contains(string(encoded), `"notificationIDList":[1,2]`) {
+		t.Errorf("Expected notification IDs to be sent as an array, got %s", encoded)
+	}
+}
+
+// TestUpdateMonitorNullFields tests that fields listed in NullFields are sent as null.
+func TestUpdateMonitorNullFields(t *testing.T) {
+	var fields map[string]json.RawMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/login/access-token" {
+			_ = json.NewEncoder(w).Encode(TokenResponse{AccessToken: "test-token-12345", TokenType: "Bearer"})
+			return
+		}
+
+		if r.URL.Path == "/monitors/1" && r.Method == http.MethodPatch {
+			if err := json.NewDecoder(r.Body).Decode(&fields); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write([]byte(`{"id":1,"type":"http","name":"HTTP"}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client, err := New(&Config{
+		BaseURL:  server.URL,
+		Username: "testuser",
+		Password: "testpass",
+		Timeout:  5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	monitor := &Monitor{
+		Type:       MonitorTypeHTTP,
+		Name:       "HTTP",
+		URL:        "https://example.com",
+		NullFields: []string{"body", "headers", "keyword", "port"},
+	}
+	if _, err := client.UpdateMonitor(context.Background(), 1, monitor); err != nil {
+		t.Fatalf("UpdateMonitor failed: %v", err)
+	}
+
+	for _, name := range monitor.NullFields {
+		if value, ok := fields[name]; !ok || string(value) != "null" {
+			t.Errorf("Expected %s to be sent as null, got %s", name, value)
+		}
+	}
+	if string(fields["maxredirects"]) != "0" {
+		t.Errorf("Expected maxredirects to be sent as 0, got %s", fields["maxredirects"])
+	}
+	if string(fields["url"]) != `"https://example.com"` {
+		t.Errorf("Expected url to be sent unchanged, got %s", fields["url"])
+	}
+	if _, ok := fields["NullFields"]; ok {
+		t.Error("Expected NullFields not to be sent")
+	}
+}
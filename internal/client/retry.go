@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	// BaseBackoff is the wait before the first retry; it doubles on every attempt.
+	BaseBackoff time.Duration
+	// MaxBackoff caps the wait between attempts, including waits from Retry-After.
+	MaxBackoff time.Duration
+	// Jitter is the fraction (0 to 1) of each wait that is randomised.
+	Jitter float64
+	// RetryableStatuses lists the response status codes that are retried.
+	RetryableStatuses []int
+	// RetryableMethods lists the HTTP methods that may be retried.
+	RetryableMethods []string
+}
+
+// DefaultRetryPolicy returns the retry policy used when Config.RetryPolicy is nil.
+// Only safe methods are retried, so a request is never applied twice.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 4,
+		BaseBackoff: 1 * time.Second,
+		MaxBackoff:  30 * time.Second,
+		Jitter:      0.2,
+		RetryableStatuses: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryableMethods: []string{
+			http.MethodGet,
+			http.MethodHead,
+			http.MethodOptions,
+		},
+	}
+}
+
+// canRetry reports whether another attempt is allowed for the method.
+func (p *RetryPolicy) canRetry(method string, attempt int) bool {
+	return attempt < p.MaxAttempts && slices.Contains(p.RetryableMethods, method)
+}
+
+// retryableStatus reports whether the status code should be retried.
+func (p *RetryPolicy) retryableStatus(statusCode int) bool {
+	return slices.Contains(p.RetryableStatuses, statusCode)
+}
+
+// retryableError reports whether a request that failed without a response should be retried.
+// Only network failures are retried. API errors raised inside the transport, such as a failed
+// login, are retried when their status is retryable.
+func (p *RetryPolicy) retryableError(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return p.retryableStatus(apiErr.StatusCode)
+	}
+
+	// TLS alerts from the server, such as a missing client certificate, are
+	// reported as network errors but fail the same way on every attempt.
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return opErr.Op != "remote error"
+	}
+
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// backoff returns the wait before the given retry attempt (starting at 1).
+// A Retry-After header on resp takes precedence over the computed backoff.
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			return min(wait, p.MaxBackoff)
+		}
+	}
+
+	wait := float64(p.BaseBackoff) * math.Pow(2, float64(attempt-1))
+	if wait > float64(p.MaxBackoff) {
+		wait = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		wait -= wait * p.Jitter * rand.Float64()
+	}
+
+	return time.Duration(wait)
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+
+	return 0, false
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
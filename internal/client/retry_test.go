@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newRetryTestServer returns a server that fails the first failures requests to /test with status.
+func newRetryTestServer(t *testing.T, failures int32, status int, header http.Header, attempts *atomic.Int32, bodies *[]string) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/login/access-token" {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(TokenResponse{
+				AccessToken: "test-token-12345",
+				TokenType:   "Bearer",
+			})
+			return
+		}
+
+		n := attempts.Add(1)
+		if bodies != nil {
+			body, _ := io.ReadAll(r.Body)
+			*bodies = append(*bodies, string(body))
+		}
+
+		if n <= failures {
+			for key, values := range header {
+				for _, v := range values {
+					w.Header().Add(key, v)
+				}
+			}
+			w.WriteHeader(status)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"success"}`))
+	}))
+}
+
+// testRetryPolicy returns a fast retry policy for tests.
+func testRetryPolicy() *RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.BaseBackoff = time.Millisecond
+	policy.MaxBackoff = 10 * time.Millisecond
+	return policy
+}
+
+// TestRetryOnRetryableStatus tests that GET requests are retried until they succeed.
+func TestRetryOnRetryableStatus(t *testing.T) {
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable} {
+		var attempts atomic.Int32
+		server := newRetryTestServer(t, 2, status, nil, &attempts, nil)
+
+		client, err := New(&Config{
+			BaseURL:     server.URL,
+			Username:    "testuser",
+			Password:    "testpass",
+			RetryPolicy: testRetryPolicy(),
+		})
+		if err != nil {
+			t.Fatalf("Failed to create client: %v", err)
+		}
+
+		var result map[string]interface{}
+		if err := client.Get(context.Background(), "/test", &result); err != nil {
+			t.Fatalf("Expected request to succeed after retries on %d, got: %v", status, err)
+		}
+		if attempts.Load() != 3 {
+			t.Errorf("Expected 3 attempts on %d, got %d", status, attempts.Load())
+		}
+		if result["status"] != "success" {
+			t.Errorf("Unexpected result: %v", result)
+		}
+
+		server.Close()
+	}
+}
+
+// TestRetryGivesUpAfterMaxAttempts tests that the last error is returned once attempts are exhausted.
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	var attempts atomic.Int32
+	server := newRetryTestServer(t, 100, http.StatusServiceUnavailable, nil, &attempts, nil)
+	defer server.Close()
+
+	policy := testRetryPolicy()
+	policy.MaxAttempts = 3
+
+	client, err := New(&Config{
+		BaseURL:     server.URL,
+		Username:    "testuser",
+		Password:    "testpass",
+		RetryPolicy: policy,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	err = client.Get(context.Background(), "/test", nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("Expected 503 APIError, got: %v", err)
+	}
+	if attempts.Load() != 3 {
+		t.Errorf("Expected 3 attempts, got %d", attempts.Load())
+	}
+}
+
+// TestNoRetryForUnsafeMethods tests that POST requests are not retried by default.
+func TestNoRetryForUnsafeMethods(t *testing.T) {
+	var attempts atomic.Int32
+	server := newRetryTestServer(t, 1, http.StatusServiceUnavailable, nil, &attempts, nil)
+	defer server.Close()
+
+	client, err := New(&Config{
+		BaseURL:     server.URL,
+		Username:    "testuser",
+		Password:    "testpass",
+		RetryPolicy: testRetryPolicy(),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	err = client.Post(context.Background(), "/test", strings.NewReader(`{}`), nil)
+	if err == nil {
+		t.Fatalf("Expected POST to fail without retry")
+	}
+	if attempts.Load() != 1 {
+		t.Errorf("Expected 1 attempt, got %d", attempts.Load())
+	}
+}
+
+// TestRetryReplaysBody tests that the request body is sent again on every attempt.
+func TestRetryReplaysBody(t *testing.T) {
+	var attempts atomic.Int32
+	var bodies []string
+	server := newRetryTestServer(t, 2, http.StatusBadGateway, nil, &attempts, &bodies)
+	defer server.Close()
+
+	policy := testRetryPolicy()
+	policy.RetryableMethods = append(policy.RetryableMethods, http.MethodPut)
+
+	client, err := New(&Config{
+		BaseURL:     server.URL,
+		Username:    "testuser",
+		Password:    "testpass",
+		RetryPolicy: policy,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if err := client.Put(context.Background(), "/test", strings.NewReader(`{"key":"value"}`), nil); err != nil {
+		t.Fatalf("PUT request failed: %v", err)
+	}
+	if len(bodies) != 3 {
+		t.Fatalf("Expected 3 attempts, got %d", len(bodies))
+	}
+	for i, body := range bodies {
+		if body != `{"key":"value"}` {
+			t.Errorf("Attempt %d sent body %q", i+1, body)
+		}
+	}
+}
+
+// TestRetryHonoursRetryAfter tests that Retry-After is used and capped by MaxBackoff.
+func TestRetryHonoursRetryAfter(t *testing.T) {
+	var attempts atomic.Int32
+	header := http.Header{"Retry-After": []string{"1"}}
+	server := newRetryTestServer(t, 1, http.StatusTooManyRequests, header, &attempts, nil)
+	defer server.Close()
+
+	policy := testRetryPolicy()
+	policy.MaxBackoff = 50 * time.Millisecond
+
+	client, err := New(&Config{
+		BaseURL:     server.URL,
+		Username:    "testuser",
+		Password:    "testpass",
+		RetryPolicy: policy,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	start := time.Now()
+	if err := client.Get(context.Background(), "/test", nil); err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	elapsed := time.Since(start)
+
+	if elapsed < 50*time.Millisecond || elapsed > 900*time.Millisecond {
+		t.Errorf("Expected wait to be capped at MaxBackoff, took %s", elapsed)
+	}
+}
+
+// TestParseRetryAfter tests parsing of both Retry-After formats.
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{value: "", ok: false},
+		{value: "5", want: 5 * time.Second, ok: true},
+		{value: "-1", ok: false},
+		{value: "Wed, 01 Jan 2025 12:00:10 GMT", want: 10 * time.Second, ok: true},
+		{value: "Wed, 01 Jan 2025 11:59:00 GMT", want: 0, ok: true},
+		{value: "soon", ok: false},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseRetryAfter(tt.value, now)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %s, %v; want %s, %v", tt.value, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+// TestRetryStopsOnContextCancel tests that waiting between attempts respects the context.
+func TestRetryStopsOnContextCancel(t *testing.T) {
+	var attempts atomic.Int32
+	server := newRetryTestServer(t, 100, http.StatusServiceUnavailable, nil, &attempts, nil)
+	defer server.Close()
+
+	policy := testRetryPolicy()
+	policy.BaseBackoff = time.Minute
+	policy.MaxBackoff = time.Minute
+
+	client, err := New(&Config{
+		BaseURL:     server.URL,
+		Username:    "testuser",
+		Password:    "testpass",
+		RetryPolicy: policy,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err = client.Get(ctx, "/test", nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context deadline error, got: %v", err)
+	}
+	if attempts.Load() != 1 {
+		t.Errorf("Expected 1 attempt, got %d", attempts.Load())
+	}
+}
+
+// TestRetryableError tests which transport failures are retried.
+func TestRetryableError(t *testing.T) {
+	policy := DefaultRetryPolicy()
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "connection refused", err: fmt.Errorf("failed to execute request: %w", &net.OpError{Op: "dial", Err: errors.New("connection refused")}), want: true},
+		{name: "connection closed", err: io.ErrUnexpectedEOF, want: true},
+		{name: "tls alert", err: &net.OpError{Op: "remote error", Err: errors.New("tls: certificate required")}, want: false},
+		{name: "retryable login status", err: fmt.Errorf("authentication failed: %w", &APIError{StatusCode: http.StatusServiceUnavailable}), want: true},
+		{name: "failed login", err: fmt.Errorf("authentication failed: %w", &APIError{StatusCode: http.StatusUnauthorized}), want: false},
+		{name: "expired token", err: errors.New("access token expired"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.retryableError(tt.err); got != tt.want {
+				t.Errorf("retryableError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
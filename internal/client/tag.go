@@ -50,6 +50,20 @@ func (c *Client) CreateTag(ctx context.Context, tag *Tag) (*Tag, error) {
 	return &result, nil
 }
 
+// UpdateTag updates an existing tag.
+func (c *Client) UpdateTag(ctx context.Context, id int, tag *Tag) error {
+	data, err := json.Marshal(tag)
+	if err != nil {
+		return fmt.Errorf("failed to marshal tag: %w", err)
+	}
+
+	path := fmt.Sprintf("/tags/%d", id)
+	if err := c.Patch(ctx, path, bytes.NewReader(data), nil); err != nil {
+		return fmt.Errorf("failed to update tag %d: %w", id, err)
+	}
+	return nil
+}
+
 // DeleteTag deletes a tag.
 func (c *Client) DeleteTag(ctx context.Context, id int) error {
 	path := fmt.Sprintf("/tags/%d", id)
@@ -135,7 +135,18 @@ func TestTagOperations(t *testing.T) {
 				w.WriteHeader(http.StatusOK)
 				// Optionally encode a success message.
 				return
-			// Add PUT/PATCH for tag updates if needed.
+			case http.MethodPatch:
+				// Update tag.
+				var update Tag
+				if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+					http.Error(w, "Bad request body", http.StatusBadRequest)
+					return
+				}
+				tags[tagIndex].Name = update.Name
+				tags[tagIndex].Color = update.Color
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"msg":"Saved."}`))
+				return
 			default:
 				http.Error(w, "Method not allowed for this resource", http.StatusMethodNotAllowed)
 				return
@@ -200,6 +211,18 @@ func TestTagOperations(t *testing.T) {
 		t.Errorf("CreateTag did not assign an ID: %+v", createdTag)
 	}
 
+	// Test UpdateTag.
+	if err := client.UpdateTag(ctx, 1, &Tag{Name: "prod", Color: "#DC2626"}); err != nil {
+		t.Fatalf("UpdateTag failed for ID 1: %v", err)
+	}
+	tag, err = client.GetTag(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetTag failed after update: %v", err)
+	}
+	if tag.ID != 1 || tag.Name != "prod" || tag.Color != "#DC2626" {
+		t.Errorf("UpdateTag did not update the tag: %+v", tag)
+	}
+
 	// Test DeleteTag.
 	deleteTargetID := 2 // Delete the 'development' tag.
 	if err := client.DeleteTag(ctx, deleteTargetID); err != nil {
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+)
+
+// ParseCACertPEM returns a certificate pool holding the system roots plus the PEM encoded certificates.
+func ParseCACertPEM(caCertPEM string) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+
+	if !pool.AppendCertsFromPEM([]byte(caCertPEM)) {
+		return nil, fmt.Errorf("no valid PEM encoded certificates found")
+	}
+
+	return pool, nil
+}
+
+// ParseClientCertificate parses a PEM encoded client certificate and private key pair.
+func ParseClientCertificate(certPEM, keyPEM string) (tls.Certificate, error) {
+	if certPEM == "" || keyPEM == "" {
+		return tls.Certificate{}, fmt.Errorf("client certificate and client key must be set together")
+	}
+
+	cert, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("invalid client certificate or key: %w", err)
+	}
+
+	return cert, nil
+}
+
+// newTLSConfig builds the TLS configuration described by config.
+// It returns nil when config does not change any TLS defaults.
+func newTLSConfig(config *Config) (*tls.Config, error) {
+	if !config.InsecureHTTPS && config.CACertPEM == "" && config.ClientCertPEM == "" && config.ClientKeyPEM == "" {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.InsecureHTTPS,
+	}
+
+	if config.CACertPEM != "" {
+		pool, err := ParseCACertPEM(config.CACertPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CA certificate: %w", err)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.ClientCertPEM != "" || config.ClientKeyPEM != "" {
+		cert, err := ParseClientCertificate(config.ClientCertPEM, config.ClientKeyPEM)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// newTransport returns a copy of the default transport using tlsConfig.
+func newTransport(tlsConfig *tls.Config) http.RoundTripper {
+	if tlsConfig == nil {
+		return nil
+	}
+
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if defaultTransport, ok := http.DefaultTransport.(*http.Transport); ok {
+		transport = defaultTransport.Clone()
+	}
+	transport.TLSClientConfig = tlsConfig
+	return transport
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTLSTestHandler returns a handler that serves logins and a successful /test endpoint.
+func newTLSTestHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/login/access-token" {
+			_ = json.NewEncoder(w).Encode(TokenResponse{AccessToken: "test-token-12345", TokenType: "Bearer"})
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"success"}`))
+	})
+}
+
+// serverCAPEM returns the PEM encoded certificate of a TLS test server.
+func serverCAPEM(server *httptest.Server) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+}
+
+// generateClientCertificate returns a self-signed client certificate and key in PEM format.
+func generateClientCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("Failed to marshal key: %v", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(certPEM), string(keyPEM)
+}
+
+// TestTLSVerification tests insecure_https and custom CA handling.
+func TestTLSVerification(t *testing.T) {
+	server := httptest.NewTLSServer(newTLSTestHandler())
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr bool
+	}{
+		{name: "untrusted certificate", config: Config{}, wantErr: true},
+		{name: "insecure", config: Config{InsecureHTTPS: true}},
+		{name: "custom CA", config: Config{CACertPEM: serverCAPEM(server)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tt.config
+			config.BaseURL = server.URL
+			config.Username = "testuser"
+			config.Password = "testpass"
+			config.Timeout = 5 * time.Second
+
+			client, err := New(&config)
+			if err != nil {
+				t.Fatalf("Failed to create client: %v", err)
+			}
+
+			err = client.Get(context.Background(), "/test", nil)
+			if tt.wantErr && err == nil {
+				t.Fatalf("Expected TLS verification error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("Request failed: %v", err)
+			}
+		})
+	}
+}
+
+// TestTLSClientCertificate tests that the client certificate is presented for mutual TLS.
+func TestTLSClientCertificate(t *testing.T) {
+	certPEM, keyPEM := generateClientCertificate(t)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AppendCertsFromPEM([]byte(certPEM))
+
+	server := httptest.NewUnstartedServer(newTLSTestHandler())
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	// Without a client certificate the handshake fails.
+	client, err := New(&Config{
+		BaseURL:   server.URL,
+		Username:  "testuser",
+		Password:  "testpass",
+		CACertPEM: serverCAPEM(server),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	if err := client.Get(context.Background(), "/test", nil); err == nil {
+		t.Fatalf("Expected handshake error without client certificate")
+	}
+
+	client, err = New(&Config{
+		BaseURL:       server.URL,
+		Username:      "testuser",
+		Password:      "testpass",
+		CACertPEM:     serverCAPEM(server),
+		ClientCertPEM: certPEM,
+		ClientKeyPEM:  keyPEM,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	if err := client.Get(context.Background(), "/test", nil); err != nil {
+		t.Fatalf("Request with client certificate failed: %v", err)
+	}
+}
+
+// TestInvalidTLSConfig tests that malformed TLS material is rejected by New.
+func TestInvalidTLSConfig(t *testing.T) {
+	certPEM, keyPEM := generateClientCertificate(t)
+
+	tests := []struct {
+		name    string
+		config  Config
+		message string
+	}{
+		{name: "invalid CA", config: Config{CACertPEM: "not a certificate"}, message: "invalid CA certificate"},
+		{name: "certificate without key", config: Config{ClientCertPEM: certPEM}, message: "must be set together"},
+		{name: "key without certificate", config: Config{ClientKeyPEM: keyPEM}, message: "must be set together"},
+		{name: "mismatched key", config: Config{ClientCertPEM: certPEM, ClientKeyPEM: "garbage"}, message: "invalid client certificate or key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tt.config
+			config.BaseURL = "https://uptime.example.com"
+			config.Username = "testuser"
+			config.Password = "testpass"
+
+			_, err := New(&config)
+			if err == nil || !strings.Contains(err.Error(), tt.message) {
+				t.Fatalf("Expected error containing %q, got: %v", tt.message, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" // #nosec G505 -- RFC 6238 TOTP codes are defined over HMAC-SHA1.
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// totpPeriod is the lifetime of a single TOTP code.
+	totpPeriod = 30 * time.Second
+	// totpDigits is the number of digits in a TOTP code.
+	totpDigits = 6
+)
+
+// ErrTOTPRequired is returned when the server requires a two-factor code but no TOTP secret is configured.
+var ErrTOTPRequired = errors.New("two-factor authentication code required but no TOTP secret is configured")
+
+// ErrTOTPRejected is returned when the server rejects the two-factor code sent at login.
+var ErrTOTPRejected = errors.New("two-factor authentication code rejected")
+
+// totpSkewSteps lists the time steps tried when logging in, so that a small clock
+// difference with the server does not fail the login: the current code first,
+// then the previous and next ones if the server rejects it.
+var totpSkewSteps = []int64{0, -1, 1}
+
+// GenerateTOTP returns the RFC 6238 code for a base32 encoded secret at the given time.
+func GenerateTOTP(secret string, t time.Time) (string, error) {
+	return generateTOTPAtStep(secret, t.Unix()/int64(totpPeriod.Seconds()))
+}
+
+// generateTOTPAtStep returns the RFC 6238 code for a base32 encoded secret at a time step.
+func generateTOTPAtStep(secret string, step int64) (string, error) {
+	key, err := decodeTOTPSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation as described in RFC 4226, section 5.3.
+	offset := sum[len(sum)-1] & 0x0f
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", totpDigits, code%1000000), nil
+}
+
+// decodeTOTPSecret decodes a base32 secret, ignoring case, spaces and padding.
+func decodeTOTPSecret(secret string) ([]byte, error) {
+	normalized := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(secret), " ", ""))
+	normalized = strings.TrimRight(normalized, "=")
+	if normalized == "" {
+		return nil, fmt.Errorf("TOTP secret is empty")
+	}
+
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(normalized)
+	if err != nil {
+		return nil, fmt.Errorf("TOTP secret is not valid base32: %w", err)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testTOTPSecret is the RFC 6238 SHA-1 test seed "12345678901234567890" in base32.
+const testTOTPSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+// TestGenerateTOTP tests code generation against the RFC 6238 test vectors.
+func TestGenerateTOTP(t *testing.T) {
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+	}
+
+	for _, tt := range tests {
+		got, err := GenerateTOTP(testTOTPSecret, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Fatalf("GenerateTOTP(%d) failed: %v", tt.unix, err)
+		}
+		if got != tt.want {
+			t.Errorf("GenerateTOTP(%d) = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+
+	// Secrets are accepted in lower case, with spaces and with padding.
+	got, err := GenerateTOTP("gezd gnbv gy3t qojq gezd gnbv gy3t qojq====", time.Unix(59, 0))
+	if err != nil || got != "287082" {
+		t.Errorf("Expected normalized secret to produce 287082, got %q (%v)", got, err)
+	}
+
+	if _, err := GenerateTOTP("not-base32!", time.Unix(59, 0)); err == nil {
+		t.Errorf("Expected error for invalid secret")
+	}
+}
+
+// totpLoginServer returns a server whose login endpoint accepts only the code for the given time.
+func totpLoginServer(t *testing.T, serverTime time.Time, logins *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	want, err := GenerateTOTP(testTOTPSecret, serverTime)
+	if err != nil {
+		t.Fatalf("GenerateTOTP failed: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/login/access-token" {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status":"success"}`))
+			return
+		}
+
+		logins.Add(1)
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.PostForm.Get("password") != "testpass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"detail":"Incorrect username or password."}`))
+			return
+		}
+
+		switch r.PostForm.Get("token") {
+		case "":
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"detail":{"tokenRequired":true}}`))
+		case want:
+			_ = json.NewEncoder(w).Encode(TokenResponse{AccessToken: "test-token-12345", TokenType: "Bearer"})
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"detail":"Invalid Token!"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// TestTOTPLogin tests that the TOTP code is sent at login and that clock skew is tolerated.
+func TestTOTPLogin(t *testing.T) {
+	clientTime := time.Date(2025, 1, 1, 12, 0, 10, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		serverTime time.Time
+		password   string
+		wantLogins int32
+		wantErr    error
+	}{
+		{name: "in sync", serverTime: clientTime, wantLogins: 1},
+		{name: "server behind", serverTime: clientTime.Add(-totpPeriod), wantLogins: 2},
+		{name: "server ahead", serverTime: clientTime.Add(totpPeriod), wantLogins: 3},
+		{name: "too much skew", serverTime: clientTime.Add(5 * time.Minute), wantLogins: 3, wantErr: ErrTOTPRejected},
+		{name: "wrong password", serverTime: clientTime, password: "wrongpass", wantLogins: 1, wantErr: ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logins atomic.Int32
+			server := totpLoginServer(t, tt.serverTime, &logins)
+
+			password := tt.password
+			if password == "" {
+				password = "testpass"
+			}
+
+			auth := NewAuthClient(server.URL, "testuser", password, nil)
+			if err := auth.SetTOTPSecret(testTOTPSecret); err != nil {
+				t.Fatalf("SetTOTPSecret failed: %v", err)
+			}
+			auth.now = func() time.Time { return clientTime }
+
+			_, err := auth.GetToken(context.Background())
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("GetToken failed: %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Expected %v, got: %v", tt.wantErr, err)
+			}
+			if got := logins.Load(); got != tt.wantLogins {
+				t.Errorf("Expected %d logins, got %d", tt.wantLogins, got)
+			}
+		})
+	}
+}
+
+// TestTOTPRequiredWithoutSecret tests that a server asking for a code is reported clearly.
+func TestTOTPRequiredWithoutSecret(t *testing.T) {
+	var logins atomic.Int32
+	server := totpLoginServer(t, time.Now(), &logins)
+
+	client, err := New(&Config{
+		BaseURL:  server.URL,
+		Username: "testuser",
+		Password: "testpass",
+		Timeout:  5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	err = client.Get(context.Background(), "/test", nil)
+	if !errors.Is(err, ErrTOTPRequired) {
+		t.Fatalf("Expected ErrTOTPRequired, got: %v", err)
+	}
+	if got := logins.Load(); got != 1 {
+		t.Errorf("Expected 1 login, got %d", got)
+	}
+}
+
+// TestTOTPConfigValidation tests that New rejects unusable TOTP settings.
+func TestTOTPConfigValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr string
+	}{
+		{name: "invalid secret", config: Config{Username: "admin", Password: "secret", TOTPSecret: "not-base32!"}, wantErr: "not valid base32"},
+		{name: "with access token", config: Config{AccessToken: "token", TOTPSecret: testTOTPSecret}, wantErr: "cannot be used with an access token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tt.config
+			config.BaseURL = "http://localhost:3001"
+
+			_, err := New(&config)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
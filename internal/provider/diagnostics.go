@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/kill3r-queen/terraform-provider-uptimekuma/internal/client"
+)
+
+// addClientError appends a client error to diags. Validation failures (422) are
+// reported as one diagnostic per invalid field so each problem is readable on its own.
+func addClientError(diags *diag.Diagnostics, action string, err error) {
+	if errors.Is(err, client.ErrTOTPRequired) {
+		diags.AddError(
+			"Two-Factor Authentication Required",
+			fmt.Sprintf("%s: the Uptime Kuma server requires a two-factor authentication code. "+
+				"Set totp_secret in the provider configuration or the UPTIMEKUMA_TOTP_SECRET environment variable "+
+				"to the secret of the account's 2FA device, or use an access_token instead.", action),
+		)
+		return
+	}
+
+	var apiErr *client.APIError
+	if errors.As(err, &apiErr) && len(apiErr.ValidationErrors) > 0 {
+		for _, v := range apiErr.ValidationErrors {
+			diags.AddError("Validation Error", fmt.Sprintf("%s: invalid value for %q: %s", action, v.Field(), v.Msg))
+		}
+		return
+	}
+
+	diags.AddError("Client Error", fmt.Sprintf("%s: %s", action, err))
+}
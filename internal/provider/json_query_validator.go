@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = jsonQueryExpressionValidator{}
+
+// jsonQueryExpressionValidator catches common syntax errors in a JSONata or JSONPath
+// expression: unbalanced brackets, unterminated literals and trailing operators. It
+// is not a full parser. Uptime Kuma evaluates the expression with JSONata, which
+// also accepts the common JSONPath forms such as $.status.
+type jsonQueryExpressionValidator struct{}
+
+func (v jsonQueryExpressionValidator) Description(ctx context.Context) string {
+	return "value must be a JSONata or JSONPath expression with balanced brackets, terminated literals and no trailing operator"
+}
+
+func (v jsonQueryExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonQueryExpressionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := checkJSONQueryExpression(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON Query Expression",
+			fmt.Sprintf("%s is not a well-formed JSONata or JSONPath expression: %s.", req.Path, err),
+		)
+	}
+}
+
+// checkJSONQueryExpression reports unbalanced brackets, unterminated string and
+// regular expression literals, and dangling path separators or operators.
+func checkJSONQueryExpression(expression string) error {
+	expression = strings.TrimSpace(expression)
+	if expression == "" {
+		return fmt.Errorf("expression is empty")
+	}
+
+	closing := map[rune]rune{')': '(', ']': '[', '}': '{'}
+	var open []rune
+	var quote, prev rune
+	escaped := false
+	literalEnd := -1
+
+	for i, r := range expression {
+		if quote != 0 {
+			switch {
+			case escaped:
+				escaped = false
+			case r == '\\':
+				escaped = true
+			case r == quote:
+				prev = quote
+				quote = 0
+				literalEnd = i
+			}
+			continue
+		}
+
+		switch r {
+		case '"', '\'', '`':
+			quote = r
+		case '/':
+			// A slash where an operand is expected starts a regular expression
+			// literal, as in $match(name, /^a/i); elsewhere it divides.
+			if prev == 0 || strings.ContainsRune("([{,:;?=!<>&|+-~", prev) {
+				quote = r
+			}
+		case '(', '[', '{':
+			open = append(open, r)
+		case ')', ']', '}':
+			if len(open) == 0 || open[len(open)-1] != closing[r] {
+				return fmt.Errorf("unexpected %q at position %d", r, i+1)
+			}
+			open = open[:len(open)-1]
+		}
+
+		if !unicode.IsSpace(r) {
+			prev = r
+		}
+	}
+
+	if quote == '/' {
+		return fmt.Errorf("unterminated regular expression")
+	}
+	if quote != 0 {
+		return fmt.Errorf("unterminated string literal")
+	}
+	if len(open) > 0 {
+		return fmt.Errorf("unclosed %q", open[len(open)-1])
+	}
+	// A trailing * or % is a wildcard or parent operator, as in $.items.* or Product.%.
+	if last := expression[len(expression)-1]; literalEnd != len(expression)-1 && strings.ContainsRune(".,=<>!&|+-/", rune(last)) {
+		return fmt.Errorf("expression ends with %q", last)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestCheckJSONQueryExpression(t *testing.T) {
+	valid := []string{
+		"status",
+		"$.status",
+		"$.checks[0].status",
+		`$.services[name="api"].state`,
+		`data.items[status != "ok"] ~> $count()`,
+		"$count(data.items)",
+		`$.message = "it's fine"`,
+		"$.items.*",
+		"$..*",
+		"Account.Order.**",
+		"Product.%",
+		`$match(x, /\(/)`,
+		`$match(name, /^api-[(]/i)`,
+		`$contains(path, /[a-z]+\/v1/)`,
+		`$replace(status, /"/, "")`,
+		"$.total / 2",
+		"$.total/$.count > 0.5",
+	}
+	for _, expression := range valid {
+		if err := checkJSONQueryExpression(expression); err != nil {
+			t.Errorf("Expected %q to be valid, got: %v", expression, err)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"   ",
+		"$.checks[0.status",
+		"$.checks]0[",
+		"$count(data.items",
+		`$.services[name="api].state`,
+		"$.status.",
+		"status =",
+		"$.total /",
+		`$match(x, /\(`,
+	}
+	for _, expression := range invalid {
+		if err := checkJSONQueryExpression(expression); err == nil {
+			t.Errorf("Expected %q to be invalid", expression)
+		}
+	}
+}
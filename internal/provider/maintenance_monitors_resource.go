@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/kill3r-queen/terraform-provider-uptimekuma/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MaintenanceMonitorsResource{}
+var _ resource.ResourceWithImportState = &MaintenanceMonitorsResource{}
+
+func NewMaintenanceMonitorsResource() resource.Resource {
+	return &MaintenanceMonitorsResource{}
+}
+
+// MaintenanceMonitorsResource defines the resource implementation.
+type MaintenanceMonitorsResource struct {
+	client *client.Client
+}
+
+// MaintenanceMonitorsResourceModel describes the resource data model.
+type MaintenanceMonitorsResourceModel struct {
+	MaintenanceID types.Int64   `tfsdk:"maintenance_id"`
+	MonitorIDs    []types.Int64 `tfsdk:"monitor_ids"`
+}
+
+func (r *MaintenanceMonitorsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenance_monitors"
+}
+
+func (r *MaintenanceMonitorsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Attaches monitors to an Uptime Kuma maintenance window. Only the listed monitors are managed, so several of these resources can share one maintenance window and monitors attached elsewhere are left untouched.",
+
+		Attributes: map[string]schema.Attribute{
+			"maintenance_id": schema.Int64Attribute{
+				MarkdownDescription: "Maintenance identifier.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"monitor_ids": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the monitors attached to the maintenance window by this resource.",
+				Required:            true,
+				ElementType:         types.Int64Type,
+			},
+		},
+	}
+}
+
+func (r *MaintenanceMonitorsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*UptimeKumaProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *UptimeKumaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *MaintenanceMonitorsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MaintenanceMonitorsResourceModel
+
+	// Read Terraform plan data into the model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maintenanceID := int(data.MaintenanceID.ValueInt64())
+
+	tflog.Info(ctx, "Attaching monitors to maintenance", map[string]interface{}{
+		"maintenance_id": maintenanceID,
+		"monitors":       len(data.MonitorIDs),
+	})
+
+	if err := r.replaceMonitors(ctx, maintenanceID, nil, data.MonitorIDs); err != nil {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to attach monitors to maintenance %d", maintenanceID), err)
+		return
+	}
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MaintenanceMonitorsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MaintenanceMonitorsResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maintenanceID := int(data.MaintenanceID.ValueInt64())
+	tflog.Debug(ctx, "Reading maintenance monitors from API", map[string]interface{}{"maintenance_id": maintenanceID})
+
+	monitors, err := r.client.GetMaintenanceMonitors(ctx, maintenanceID)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			// Maintenance is gone upstream, remove the association from state.
+			tflog.Warn(ctx, "Maintenance not found, removing monitors from state", map[string]interface{}{"maintenance_id": maintenanceID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to read monitors of maintenance %d", maintenanceID), err)
+		return
+	}
+
+	// Only monitors managed by this resource are tracked, unless it is being
+	// imported, in which case every attached monitor is adopted.
+	managed := monitorIDSet(data.MonitorIDs)
+	monitorIDs := make([]types.Int64, 0, len(monitors))
+	for _, monitor := range monitors {
+		if data.MonitorIDs == nil || managed[monitor.ID] {
+			monitorIDs = append(monitorIDs, types.Int64Value(int64(monitor.ID)))
+		}
+	}
+	data.MonitorIDs = monitorIDs
+
+	// Save updated data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MaintenanceMonitorsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state MaintenanceMonitorsResourceModel
+
+	// Read Terraform plan and prior state data into the models.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maintenanceID := int(data.MaintenanceID.ValueInt64())
+
+	tflog.Info(ctx, "Updating maintenance monitors", map[string]interface{}{
+		"maintenance_id": maintenanceID,
+		"monitors":       len(data.MonitorIDs),
+	})
+
+	if err := r.replaceMonitors(ctx, maintenanceID, state.MonitorIDs, data.MonitorIDs); err != nil {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to update monitors of maintenance %d", maintenanceID), err)
+		return
+	}
+
+	// Save updated data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MaintenanceMonitorsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MaintenanceMonitorsResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maintenanceID := int(data.MaintenanceID.ValueInt64())
+
+	tflog.Info(ctx, "Detaching monitors from maintenance", map[string]interface{}{
+		"maintenance_id": maintenanceID,
+	})
+
+	err := r.replaceMonitors(ctx, maintenanceID, data.MonitorIDs, nil)
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to detach monitors from maintenance %d", maintenanceID), err)
+		return
+	}
+}
+
+func (r *MaintenanceMonitorsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The maintenance ID identifies the association.
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Maintenance ID",
+			fmt.Sprintf("Maintenance ID must be a number, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("maintenance_id"), id)...)
+}
+
+// replaceMonitors swaps the monitors previously attached by this resource for the
+// desired ones. The API replaces the full list, so monitors attached by other
+// resources are read first and sent back unchanged.
+func (r *MaintenanceMonitorsResource) replaceMonitors(ctx context.Context, maintenanceID int, previous, desired []types.Int64) error {
+	current, err := r.client.GetMaintenanceMonitors(ctx, maintenanceID)
+	if err != nil {
+		return err
+	}
+
+	previousIDs := monitorIDSet(previous)
+	desiredIDs := monitorIDSet(desired)
+
+	monitors := make([]client.MaintenanceMonitor, 0, len(current)+len(desired))
+	for _, monitor := range current {
+		if !previousIDs[monitor.ID] && !desiredIDs[monitor.ID] {
+			monitors = append(monitors, monitor)
+		}
+	}
+	for _, monitorID := range desired {
+		monitors = append(monitors, client.MaintenanceMonitor{ID: int(monitorID.ValueInt64())})
+	}
+
+	return r.client.SetMaintenanceMonitors(ctx, maintenanceID, monitors)
+}
+
+// monitorIDSet returns the given monitor IDs as a set.
+func monitorIDSet(monitorIDs []types.Int64) map[int]bool {
+	set := make(map[int]bool, len(monitorIDs))
+	for _, monitorID := range monitorIDs {
+		set[int(monitorID.ValueInt64())] = true
+	}
+	return set
+}
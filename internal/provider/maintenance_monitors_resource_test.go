@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccMaintenanceMonitorsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing.
+			{
+				Config: testAccMaintenanceMonitorsResourceConfig("uptimekuma_monitor.first.id"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_maintenance_monitors.test",
+						tfjsonpath.New("monitor_ids"),
+						knownvalue.SetSizeExact(1),
+					),
+				},
+			},
+			// ImportState testing.
+			{
+				ResourceName:                         "uptimekuma_maintenance_monitors.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "maintenance_id",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["uptimekuma_maintenance_monitors.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found in state")
+					}
+					return rs.Primary.Attributes["maintenance_id"], nil
+				},
+			},
+			// Update and Read testing.
+			{
+				Config: testAccMaintenanceMonitorsResourceConfig("uptimekuma_monitor.first.id, uptimekuma_monitor.second.id"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_maintenance_monitors.test",
+						tfjsonpath.New("monitor_ids"),
+						knownvalue.SetSizeExact(2),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase.
+		},
+	})
+}
+
+func testAccMaintenanceMonitorsResourceConfig(monitorIDs string) string {
+	return fmt.Sprintf(`
+resource "uptimekuma_monitor" "first" {
+name        = "Maintenance Monitor 1"
+type        = "http"
+url         = "https://example.com"
+description = "first"
+}
+
+resource "uptimekuma_monitor" "second" {
+name        = "Maintenance Monitor 2"
+type        = "http"
+url         = "https://example.org"
+description = "second"
+}
+
+resource "uptimekuma_maintenance" "test" {
+title    = "Shared maintenance"
+strategy = "manual"
+}
+
+resource "uptimekuma_maintenance_monitors" "test" {
+maintenance_id = uptimekuma_maintenance.test.id
+monitor_ids    = [%[1]s]
+}
+`,
+		monitorIDs)
+}
@@ -0,0 +1,689 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/kill3r-queen/terraform-provider-uptimekuma/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MaintenanceResource{}
+var _ resource.ResourceWithImportState = &MaintenanceResource{}
+var _ resource.ResourceWithValidateConfig = &MaintenanceResource{}
+
+var (
+	maintenanceDateTimeRegexp = regexp.MustCompile(`^\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}$`)
+	maintenanceTimeRegexp     = regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d$`)
+)
+
+// maintenanceStrategyAttributes lists, per strategy, the schedule attributes that
+// must be set and those that may be set. Any other schedule attribute is rejected.
+var maintenanceStrategyAttributes = map[client.MaintenanceStrategy]struct {
+	required []string
+	optional []string
+}{
+	client.MaintenanceStrategyManual: {},
+	client.MaintenanceStrategySingle: {
+		required: []string{"date_range"},
+		optional: []string{"timezone"},
+	},
+	client.MaintenanceStrategyRecurringInterval: {
+		required: []string{"interval_day", "time_range"},
+		optional: []string{"date_range", "timezone"},
+	},
+	client.MaintenanceStrategyRecurringWeekday: {
+		required: []string{"weekdays", "time_range"},
+		optional: []string{"date_range", "timezone"},
+	},
+	client.MaintenanceStrategyRecurringDayOfMonth: {
+		required: []string{"days_of_month", "time_range"},
+		optional: []string{"date_range", "timezone"},
+	},
+	client.MaintenanceStrategyCron: {
+		required: []string{"cron", "duration_minutes"},
+		optional: []string{"date_range", "timezone"},
+	},
+}
+
+// maintenanceNullableFields maps optional schedule attributes to the API fields that
+// are sent as null when the attribute is removed, since omitted fields are left unchanged.
+var maintenanceNullableFields = map[string]string{
+	"date_range":       "dateRange",
+	"interval_day":     "intervalDay",
+	"time_range":       "timeRange",
+	"cron":             "cron",
+	"duration_minutes": "durationMinutes",
+}
+
+func NewMaintenanceResource() resource.Resource {
+	return &MaintenanceResource{}
+}
+
+// MaintenanceResource defines the resource implementation.
+type MaintenanceResource struct {
+	client *client.Client
+}
+
+// MaintenanceDateRangeModel describes the period in which a maintenance window applies.
+type MaintenanceDateRangeModel struct {
+	Start types.String `tfsdk:"start"`
+	End   types.String `tfsdk:"end"`
+}
+
+// MaintenanceTimeRangeModel describes the time of day a recurring maintenance window runs.
+type MaintenanceTimeRangeModel struct {
+	Start types.String `tfsdk:"start"`
+	End   types.String `tfsdk:"end"`
+}
+
+// MaintenanceResourceModel describes the resource data model.
+type MaintenanceResourceModel struct {
+	ID              types.Int64                `tfsdk:"id"`
+	Title           types.String               `tfsdk:"title"`
+	Description     types.String               `tfsdk:"description"`
+	Strategy        types.String               `tfsdk:"strategy"`
+	Active          types.Bool                 `tfsdk:"active"`
+	DateRange       *MaintenanceDateRangeModel `tfsdk:"date_range"`
+	IntervalDay     types.Int64                `tfsdk:"interval_day"`
+	Weekdays        []types.Int64              `tfsdk:"weekdays"`
+	DaysOfMonth     []types.Int64              `tfsdk:"days_of_month"`
+	TimeRange       *MaintenanceTimeRangeModel `tfsdk:"time_range"`
+	Cron            types.String               `tfsdk:"cron"`
+	DurationMinutes types.Int64                `tfsdk:"duration_minutes"`
+	Timezone        types.String               `tfsdk:"timezone"`
+}
+
+func (r *MaintenanceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenance"
+}
+
+func (r *MaintenanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	strategies := make([]string, 0, len(maintenanceStrategyAttributes))
+	for _, strategy := range []client.MaintenanceStrategy{
+		client.MaintenanceStrategyManual,
+		client.MaintenanceStrategySingle,
+		client.MaintenanceStrategyRecurringInterval,
+		client.MaintenanceStrategyRecurringWeekday,
+		client.MaintenanceStrategyRecurringDayOfMonth,
+		client.MaintenanceStrategyCron,
+	} {
+		strategies = append(strategies, string(strategy))
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an Uptime Kuma maintenance window. Monitors affected by an active maintenance window are reported as under maintenance instead of down.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Maintenance identifier.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Maintenance title.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Maintenance description.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"strategy": schema.StringAttribute{
+				MarkdownDescription: "Scheduling strategy: `manual`, `single`, `recurring-interval`, `recurring-weekday`, `recurring-day-of-month` or `cron`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(strategies...),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the maintenance window is active. Set to `false` to pause it.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"date_range": schema.SingleNestedAttribute{
+				MarkdownDescription: "Period in which the maintenance window applies. Required for the `single` strategy and optional for the recurring and `cron` strategies.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"start": schema.StringAttribute{
+						MarkdownDescription: "Start date and time, formatted as `YYYY-MM-DD HH:MM:SS`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(maintenanceDateTimeRegexp, "must be formatted as YYYY-MM-DD HH:MM:SS"),
+						},
+					},
+					"end": schema.StringAttribute{
+						MarkdownDescription: "End date and time, formatted as `YYYY-MM-DD HH:MM:SS`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(maintenanceDateTimeRegexp, "must be formatted as YYYY-MM-DD HH:MM:SS"),
+						},
+					},
+				},
+			},
+			"interval_day": schema.Int64Attribute{
+				MarkdownDescription: "Number of days between runs. Required for the `recurring-interval` strategy.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 3650),
+				},
+			},
+			"weekdays": schema.ListAttribute{
+				MarkdownDescription: "Days of the week on which the maintenance window runs (Sunday = 0, Monday = 1, ..., Saturday = 6). Required for the `recurring-weekday` strategy.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueInt64sAre(int64validator.Between(0, 6)),
+				},
+			},
+			"days_of_month": schema.ListAttribute{
+				MarkdownDescription: "Days of the month on which the maintenance window runs. Required for the `recurring-day-of-month` strategy.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueInt64sAre(int64validator.Between(1, 31)),
+				},
+			},
+			"time_range": schema.SingleNestedAttribute{
+				MarkdownDescription: "Time of day during which the maintenance window runs. Required for the recurring strategies.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"start": schema.StringAttribute{
+						MarkdownDescription: "Start time, formatted as `HH:MM`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(maintenanceTimeRegexp, "must be formatted as HH:MM"),
+						},
+					},
+					"end": schema.StringAttribute{
+						MarkdownDescription: "End time, formatted as `HH:MM`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(maintenanceTimeRegexp, "must be formatted as HH:MM"),
+						},
+					},
+				},
+			},
+			"cron": schema.StringAttribute{
+				MarkdownDescription: "Cron expression that starts the maintenance window. Required for the `cron` strategy.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"duration_minutes": schema.Int64Attribute{
+				MarkdownDescription: "Duration of each maintenance window in minutes. Required for the `cron` strategy.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				MarkdownDescription: "Timezone of the schedule, e.g. `Europe/Berlin`, `UTC` or `SAME_AS_SERVER`. Defaults to the server timezone.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *MaintenanceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var strategy types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("strategy"), &strategy)...)
+	if resp.Diagnostics.HasError() || strategy.IsNull() || strategy.IsUnknown() {
+		return
+	}
+
+	rules, ok := maintenanceStrategyAttributes[client.MaintenanceStrategy(strategy.ValueString())]
+	if !ok {
+		// Unknown strategies are reported by the attribute validator.
+		return
+	}
+
+	var dateRange, timeRange types.Object
+	var weekdays, daysOfMonth types.List
+	var intervalDay, durationMinutes types.Int64
+	var cron, timezone types.String
+	targets := map[string]interface{}{
+		"date_range":       &dateRange,
+		"interval_day":     &intervalDay,
+		"weekdays":         &weekdays,
+		"days_of_month":    &daysOfMonth,
+		"time_range":       &timeRange,
+		"cron":             &cron,
+		"duration_minutes": &durationMinutes,
+		"timezone":         &timezone,
+	}
+	for name, target := range targets {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), target)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values := map[string]attr.Value{
+		"date_range":       dateRange,
+		"interval_day":     intervalDay,
+		"weekdays":         weekdays,
+		"days_of_month":    daysOfMonth,
+		"time_range":       timeRange,
+		"cron":             cron,
+		"duration_minutes": durationMinutes,
+		"timezone":         timezone,
+	}
+
+	allowed := make(map[string]bool, len(rules.required)+len(rules.optional))
+	for _, name := range rules.required {
+		allowed[name] = true
+		if values[name].IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing Maintenance Attribute",
+				fmt.Sprintf("%s must be set when strategy is %q.", name, strategy.ValueString()),
+			)
+		}
+	}
+	for _, name := range rules.optional {
+		allowed[name] = true
+	}
+
+	for name, value := range values {
+		if !allowed[name] && !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Maintenance Attribute",
+				fmt.Sprintf("%s cannot be set when strategy is %q.", name, strategy.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *MaintenanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*UptimeKumaProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *UptimeKumaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *MaintenanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MaintenanceResourceModel
+
+	// Read Terraform plan data into the model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maintenance := newMaintenanceFromModel(&data)
+
+	tflog.Info(ctx, "Creating maintenance", map[string]interface{}{
+		"title":    maintenance.Title,
+		"strategy": maintenance.Strategy,
+	})
+
+	createdMaintenance, err := r.client.CreateMaintenance(ctx, maintenance)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to create maintenance", err)
+		return
+	}
+
+	data.ID = types.Int64Value(int64(createdMaintenance.ID))
+
+	if data.Timezone.IsUnknown() {
+		r.readTimezone(ctx, &data, &resp.Diagnostics)
+	}
+
+	// New maintenance windows start active, so pause it if requested.
+	if !data.Active.ValueBool() {
+		if err := r.client.PauseMaintenance(ctx, createdMaintenance.ID); err != nil {
+			addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to pause maintenance %d", createdMaintenance.ID), err)
+			data.Active = types.BoolValue(true)
+		}
+	}
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MaintenanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MaintenanceResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maintenanceID := int(data.ID.ValueInt64())
+	tflog.Debug(ctx, "Reading maintenance from API", map[string]interface{}{"id": maintenanceID})
+
+	maintenance, err := r.client.GetMaintenance(ctx, maintenanceID)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			// Resource is gone upstream, remove it from state.
+			tflog.Warn(ctx, "Maintenance not found, removing from state", map[string]interface{}{"id": maintenanceID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to read maintenance %d", maintenanceID), err)
+		return
+	}
+
+	data.ID = types.Int64Value(int64(maintenance.ID))
+	data.Title = types.StringValue(maintenance.Title)
+	data.Description = types.StringValue(maintenance.Description)
+	data.Strategy = types.StringValue(string(maintenance.Strategy))
+	data.Active = types.BoolValue(maintenance.Active)
+
+	// The API returns defaults for schedule fields the strategy does not use, so
+	// only the fields relevant to the strategy are copied into state.
+	strategy := maintenance.Strategy
+
+	// The date range is optional for recurring strategies, so it is only tracked
+	// when it is required or was already managed.
+	trackDateRange := strategy == client.MaintenanceStrategySingle || data.DateRange != nil
+	data.DateRange = nil
+	if trackDateRange && len(maintenance.DateRange) == 2 {
+		data.DateRange = &MaintenanceDateRangeModel{
+			Start: types.StringValue(maintenance.DateRange[0]),
+			End:   types.StringValue(maintenance.DateRange[1]),
+		}
+	}
+
+	data.IntervalDay = types.Int64Null()
+	if strategy == client.MaintenanceStrategyRecurringInterval {
+		data.IntervalDay = types.Int64Value(int64(maintenance.IntervalDay))
+	}
+
+	data.Weekdays = nil
+	if strategy == client.MaintenanceStrategyRecurringWeekday {
+		weekdays := make([]types.Int64, 0, len(maintenance.Weekdays))
+		for _, weekday := range maintenance.Weekdays {
+			weekdays = append(weekdays, types.Int64Value(int64(weekday)))
+		}
+		data.Weekdays = weekdays
+	}
+
+	data.DaysOfMonth = nil
+	if strategy == client.MaintenanceStrategyRecurringDayOfMonth {
+		daysOfMonth := make([]types.Int64, 0, len(maintenance.DaysOfMonth))
+		var special []string
+		for _, day := range maintenance.DaysOfMonth {
+			if number, ok := day.(float64); ok {
+				daysOfMonth = append(daysOfMonth, types.Int64Value(int64(number)))
+			} else {
+				special = append(special, fmt.Sprint(day))
+			}
+		}
+		data.DaysOfMonth = daysOfMonth
+
+		// Special values such as "lastDay1" cannot be configured here. They are
+		// reported as drift, since the next update replaces them.
+		if len(special) > 0 {
+			data.DaysOfMonth = nil
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("days_of_month"),
+				"Unmanaged Maintenance Days",
+				fmt.Sprintf("Maintenance %d also runs on %s, which cannot be set with days_of_month. Applying the configuration removes them.", maintenanceID, strings.Join(special, ", ")),
+			)
+		}
+	}
+
+	data.TimeRange = nil
+	switch strategy {
+	case client.MaintenanceStrategyRecurringInterval, client.MaintenanceStrategyRecurringWeekday, client.MaintenanceStrategyRecurringDayOfMonth:
+		if len(maintenance.TimeRange) == 2 {
+			data.TimeRange = &MaintenanceTimeRangeModel{
+				Start: types.StringValue(formatMaintenanceTime(maintenance.TimeRange[0])),
+				End:   types.StringValue(formatMaintenanceTime(maintenance.TimeRange[1])),
+			}
+		}
+	}
+
+	// The API may leave out the cron schedule, in which case the managed one is kept.
+	cron, durationMinutes := data.Cron, data.DurationMinutes
+	data.Cron = types.StringNull()
+	data.DurationMinutes = types.Int64Null()
+	if strategy == client.MaintenanceStrategyCron {
+		data.Cron = cron
+		if maintenance.Cron != "" {
+			data.Cron = types.StringValue(maintenance.Cron)
+		}
+		data.DurationMinutes = durationMinutes
+		if maintenance.DurationMinutes != 0 {
+			data.DurationMinutes = types.Int64Value(int64(maintenance.DurationMinutes))
+		}
+	}
+
+	// The server fills in its own timezone when none is configured, and may leave it out.
+	if maintenance.TimezoneOption != "" {
+		data.Timezone = types.StringValue(maintenance.TimezoneOption)
+	}
+
+	// Save updated data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MaintenanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state MaintenanceResourceModel
+
+	// Read Terraform plan and prior state data into the models.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maintenanceID := int(data.ID.ValueInt64())
+	maintenance := newMaintenanceFromModel(&data)
+
+	clearedFields, diags := nullFields(ctx, req.Plan, req.State, maintenanceNullableFields)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maintenance.NullFields = clearedFields
+
+	tflog.Info(ctx, "Updating maintenance", map[string]interface{}{
+		"id":    maintenanceID,
+		"title": maintenance.Title,
+	})
+
+	if err := r.client.UpdateMaintenance(ctx, maintenanceID, maintenance); err != nil {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to update maintenance %d", maintenanceID), err)
+		return
+	}
+
+	if data.Timezone.IsUnknown() {
+		r.readTimezone(ctx, &data, &resp.Diagnostics)
+	}
+
+	// Pause or resume the maintenance window when active changes.
+	if data.Active.ValueBool() != state.Active.ValueBool() {
+		if data.Active.ValueBool() {
+			if err := r.client.ResumeMaintenance(ctx, maintenanceID); err != nil {
+				addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to resume maintenance %d", maintenanceID), err)
+				return
+			}
+		} else {
+			if err := r.client.PauseMaintenance(ctx, maintenanceID); err != nil {
+				addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to pause maintenance %d", maintenanceID), err)
+				return
+			}
+		}
+	}
+
+	// Save updated data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MaintenanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MaintenanceResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maintenanceID := int(data.ID.ValueInt64())
+
+	tflog.Info(ctx, "Deleting maintenance", map[string]interface{}{
+		"id": maintenanceID,
+	})
+
+	err := r.client.DeleteMaintenance(ctx, maintenanceID)
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to delete maintenance %d", maintenanceID), err)
+		return
+	}
+}
+
+func (r *MaintenanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Maintenance ID",
+			fmt.Sprintf("Maintenance ID must be a number, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// newMaintenanceFromModel converts the Terraform model into an API maintenance.
+// readTimezone sets the timezone of a maintenance window written without one to the
+// timezone stored by the server, or null when the server does not report it.
+func (r *MaintenanceResource) readTimezone(ctx context.Context, data *MaintenanceResourceModel, diags *diag.Diagnostics) {
+	maintenanceID := int(data.ID.ValueInt64())
+	data.Timezone = types.StringNull()
+
+	maintenance, err := r.client.GetMaintenance(ctx, maintenanceID)
+	if err != nil {
+		addClientError(diags, fmt.Sprintf("Unable to read timezone of maintenance %d", maintenanceID), err)
+		return
+	}
+
+	if maintenance.TimezoneOption != "" {
+		data.Timezone = types.StringValue(maintenance.TimezoneOption)
+	}
+}
+
+func newMaintenanceFromModel(data *MaintenanceResourceModel) *client.Maintenance {
+	maintenance := &client.Maintenance{
+		Title:       data.Title.ValueString(),
+		Description: data.Description.ValueString(),
+		Strategy:    client.MaintenanceStrategy(data.Strategy.ValueString()),
+		Active:      data.Active.ValueBool(),
+		Weekdays:    []int{},
+		DaysOfMonth: []interface{}{},
+	}
+
+	if data.DateRange != nil {
+		maintenance.DateRange = []string{data.DateRange.Start.ValueString(), data.DateRange.End.ValueString()}
+	}
+
+	if !data.IntervalDay.IsNull() {
+		maintenance.IntervalDay = int(data.IntervalDay.ValueInt64())
+	}
+
+	for _, weekday := range data.Weekdays {
+		maintenance.Weekdays = append(maintenance.Weekdays, int(weekday.ValueInt64()))
+	}
+
+	for _, day := range data.DaysOfMonth {
+		maintenance.DaysOfMonth = append(maintenance.DaysOfMonth, day.ValueInt64())
+	}
+
+	if data.TimeRange != nil {
+		maintenance.TimeRange = []client.MaintenanceTime{
+			parseMaintenanceTime(data.TimeRange.Start.ValueString()),
+			parseMaintenanceTime(data.TimeRange.End.ValueString()),
+		}
+	}
+
+	if !data.Cron.IsNull() {
+		maintenance.Cron = data.Cron.ValueString()
+	}
+
+	if !data.DurationMinutes.IsNull() {
+		maintenance.DurationMinutes = int(data.DurationMinutes.ValueInt64())
+	}
+
+	if !data.Timezone.IsNull() && !data.Timezone.IsUnknown() {
+		maintenance.TimezoneOption = data.Timezone.ValueString()
+	}
+
+	return maintenance
+}
+
+// parseMaintenanceTime parses a time of day formatted as HH:MM.
+func parseMaintenanceTime(value string) client.MaintenanceTime {
+	var t client.MaintenanceTime
+	_, _ = fmt.Sscanf(value, "%d:%d", &t.Hours, &t.Minutes)
+	return t
+}
+
+// formatMaintenanceTime formats a time of day as HH:MM.
+func formatMaintenanceTime(t client.MaintenanceTime) string {
+	return fmt.Sprintf("%02d:%02d", t.Hours, t.Minutes)
+}
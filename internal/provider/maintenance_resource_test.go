@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/kill3r-queen/terraform-provider-uptimekuma/internal/client"
+)
+
+func TestAccMaintenanceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing.
+			{
+				Config: testAccMaintenanceResourceConfig("Patch window", true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_maintenance.test",
+						tfjsonpath.New("title"),
+						knownvalue.StringExact("Patch window"),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_maintenance.test",
+						tfjsonpath.New("strategy"),
+						knownvalue.StringExact("recurring-weekday"),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_maintenance.test",
+						tfjsonpath.New("active"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_maintenance.test",
+						tfjsonpath.New("time_range").AtMapKey("end"),
+						knownvalue.StringExact("03:30"),
+					),
+				},
+			},
+			// ImportState testing.
+			{
+				ResourceName:      "uptimekuma_maintenance.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing.
+			{
+				Config: testAccMaintenanceResourceConfig("Paused patch window", false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_maintenance.test",
+						tfjsonpath.New("title"),
+						knownvalue.StringExact("Paused patch window"),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_maintenance.test",
+						tfjsonpath.New("active"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase.
+		},
+	})
+}
+
+func testAccMaintenanceResourceConfig(title string, active bool) string {
+	return fmt.Sprintf(`
+resource "uptimekuma_maintenance" "test" {
+title    = %[1]q
+strategy = "recurring-weekday"
+active   = %[2]t
+weekdays = [0, 6]
+time_range = {
+  start = "02:00"
+  end   = "03:30"
+}
+}
+`,
+		title, active)
+}
+
+func TestAccMaintenanceResourceStrategyValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Required attribute of the strategy is missing.
+			{
+				Config: `
+resource "uptimekuma_maintenance" "test" {
+title    = "Cron window"
+strategy = "cron"
+cron     = "30 3 * * *"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`duration_minutes must be set when strategy is "cron"`),
+			},
+			// Attribute belongs to a different strategy.
+			{
+				Config: `
+resource "uptimekuma_maintenance" "test" {
+title    = "Manual window"
+strategy = "manual"
+weekdays = [1]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`weekdays cannot be set when strategy is "manual"`),
+			},
+		},
+	})
+}
+
+// TestMaintenanceNullFields checks that removing an optional schedule attribute clears it on update.
+func TestMaintenanceNullFields(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	(&MaintenanceResource{}).Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	data := MaintenanceResourceModel{
+		ID:          types.Int64Value(1),
+		Title:       types.StringValue("Patch window"),
+		Description: types.StringValue(""),
+		Strategy:    types.StringValue("manual"),
+		Active:      types.BoolValue(true),
+	}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, &data); diags.HasError() {
+		t.Fatalf("Failed to set plan: %v", diags)
+	}
+
+	for name, field := range maintenanceNullableFields {
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		diags := state.Set(ctx, &data)
+
+		var value interface{}
+		switch schemaResp.Schema.Attributes[name].(type) {
+		case schema.StringAttribute:
+			value = types.StringValue("value")
+		case schema.Int64Attribute:
+			value = types.Int64Value(1)
+		case schema.SingleNestedAttribute:
+			value = &MaintenanceTimeRangeModel{
+				Start: types.StringValue("value"),
+				End:   types.StringValue("value"),
+			}
+		default:
+			t.Fatalf("%s: unexpected attribute type %T", name, schemaResp.Schema.Attributes[name])
+		}
+		diags.Append(state.SetAttribute(ctx, path.Root(name), value)...)
+		if diags.HasError() {
+			t.Fatalf("%s: failed to set state: %v", name, diags)
+		}
+
+		fields, diags := nullFields(ctx, plan, state, maintenanceNullableFields)
+		if diags.HasError() {
+			t.Fatalf("%s: nullFields returned errors: %v", name, diags)
+		}
+		if !reflect.DeepEqual(fields, []string{field}) {
+			t.Errorf("%s: expected null fields %v, got %v", name, []string{field}, fields)
+		}
+	}
+}
+
+// TestMaintenanceResourceScheduleOmitted checks that a cron schedule the API does not
+// return is kept, and that a timezone the API does not report is left null.
+func TestMaintenanceResourceScheduleOmitted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path == "/login/access-token":
+			_ = json.NewEncoder(w).Encode(client.TokenResponse{AccessToken: "test-token-12345", TokenType: "Bearer"})
+		case r.URL.Path == "/maintenances" && r.Method == http.MethodPost:
+			_, _ = w.Write([]byte(`{"msg":"Added Successfully.","maintenanceID":3}`))
+		case r.URL.Path == "/maintenances/3" && r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`{"maintenance":{"id":3,"title":"Nightly","description":"","strategy":"cron","active":true,"weekdays":[],"daysOfMonth":[]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	apiClient, err := client.New(&client.Config{
+		BaseURL:     server.URL,
+		Username:    "testuser",
+		Password:    "testpass",
+		Timeout:     5 * time.Second,
+		RetryPolicy: &client.RetryPolicy{MaxAttempts: 1},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx := context.Background()
+	r := &MaintenanceResource{client: apiClient}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	expected := MaintenanceResourceModel{
+		ID:              types.Int64Value(3),
+		Title:           types.StringValue("Nightly"),
+		Description:     types.StringValue(""),
+		Strategy:        types.StringValue("cron"),
+		Active:          types.BoolValue(true),
+		IntervalDay:     types.Int64Null(),
+		Cron:            types.StringValue("0 3 * * *"),
+		DurationMinutes: types.Int64Value(60),
+		Timezone:        types.StringNull(),
+	}
+
+	planned := expected
+	planned.ID = types.Int64Unknown()
+	planned.Timezone = types.StringUnknown()
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, &planned); diags.HasError() {
+		t.Fatalf("Failed to set plan: %v", diags)
+	}
+
+	createResp := &fwresource.CreateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create returned errors: %v", createResp.Diagnostics)
+	}
+
+	var created MaintenanceResourceModel
+	if diags := createResp.State.Get(ctx, &created); diags.HasError() {
+		t.Fatalf("Failed to get state: %v", diags)
+	}
+	if !reflect.DeepEqual(created, expected) {
+		t.Errorf("Expected created state %+v, got %+v", expected, created)
+	}
+
+	readResp := &fwresource.ReadResponse{State: createResp.State}
+	r.Read(ctx, fwresource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", readResp.Diagnostics)
+	}
+
+	var read MaintenanceResourceModel
+	if diags := readResp.State.Get(ctx, &read); diags.HasError() {
+		t.Fatalf("Failed to get state: %v", diags)
+	}
+	if !reflect.DeepEqual(read, expected) {
+		t.Errorf("Expected read state %+v, got %+v", expected, read)
+	}
+}
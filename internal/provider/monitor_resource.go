@@ -5,24 +5,138 @@ package provider
 
 import (
 	"context"
+	"crypto/rand"
+	"errors"
 	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/kill3r-queen/terraform-provider-uptimekuma/internal/client"
 )
 
+// monitorStatusCodeRegexp matches an accepted status code or status code range.
+var monitorStatusCodeRegexp = regexp.MustCompile(`^[1-5][0-9]{2}(-[1-5][0-9]{2})?$`)
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &MonitorResource{}
 var _ resource.ResourceWithImportState = &MonitorResource{}
+var _ resource.ResourceWithModifyPlan = &MonitorResource{}
+var _ resource.ResourceWithValidateConfig = &MonitorResource{}
+
+// monitorTypeAttributes lists the attributes each monitor type requires.
+var monitorTypeAttributes = map[client.MonitorType][]string{
+	client.MonitorTypePostgres:      {"database_connection_string"},
+	client.MonitorTypeMySQL:         {"database_connection_string"},
+	client.MonitorTypeSQLServer:     {"database_connection_string"},
+	client.MonitorTypeMongoDB:       {"database_connection_string"},
+	client.MonitorTypeRedis:         {"database_connection_string"},
+	client.MonitorTypeMQTT:          {"hostname", "port", "mqtt_topic"},
+	client.MonitorTypeKafkaProducer: {"kafka_producer_brokers", "kafka_producer_topic", "kafka_producer_message"},
+	client.MonitorTypeDNS:           {"hostname"},
+	client.MonitorTypeRadius:        {"hostname", "radius_username", "radius_password", "radius_secret"},
+	client.MonitorTypeGamedig:       {"hostname", "port", "game"},
+	client.MonitorTypeSteam:         {"hostname", "port"},
+	client.MonitorTypeTailscalePing: {"hostname"},
+	client.MonitorTypeJSONQuery:     {"url", "json_path", "expected_value"},
+	client.MonitorTypeDocker:        {"docker_container", "docker_host_id"},
+}
+
+// monitorPushTokenRegexp matches the characters allowed in a push token, which is
+// used as a URL path segment.
+var monitorPushTokenRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// monitorPushTokenAlphabet and monitorPushTokenLength match the tokens generated by
+// the Uptime Kuma UI.
+const (
+	monitorPushTokenAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+	monitorPushTokenLength   = 32
+)
+
+// monitorNullableFields maps optional attributes to the API fields that are sent as
+// null when the attribute is removed, since omitted fields are left unchanged.
+var monitorNullableFields = map[string]string{
+	"url":                         "url",
+	"hostname":                    "hostname",
+	"port":                        "port",
+	"body":                        "body",
+	"headers":                     "headers",
+	"auth_method":                 "authMethod",
+	"basic_auth_user":             "basic_auth_user",
+	"basic_auth_pass":             "basic_auth_pass",
+	"keyword":                     "keyword",
+	"proxy_id":                    "proxyId",
+	"tls_cert":                    "tlsCert",
+	"tls_key":                     "tlsKey",
+	"tls_ca":                      "tlsCa",
+	"oauth_token_url":             "oauth_token_url",
+	"oauth_client_id":             "oauth_client_id",
+	"oauth_client_secret":         "oauth_client_secret",
+	"oauth_scopes":                "oauth_scopes",
+	"database_connection_string":  "databaseConnectionString",
+	"database_query":              "databaseQuery",
+	"mqtt_username":               "mqttUsername",
+	"mqtt_password":               "mqttPassword",
+	"mqtt_topic":                  "mqttTopic",
+	"mqtt_success_message":        "mqttSuccessMessage",
+	"kafka_producer_brokers":      "kafkaProducerBrokers",
+	"kafka_producer_topic":        "kafkaProducerTopic",
+	"kafka_producer_message":      "kafkaProducerMessage",
+	"kafka_producer_sasl_options": "kafkaProducerSaslOptions",
+	"radius_username":             "radiusUsername",
+	"radius_password":             "radiusPassword",
+	"radius_secret":               "radiusSecret",
+	"radius_called_station_id":    "radiusCalledStationId",
+	"radius_calling_station_id":   "radiusCallingStationId",
+	"game":                        "game",
+	"json_path":                   "jsonPath",
+	"expected_value":              "expectedValue",
+	"docker_container":            "docker_container",
+	"docker_host_id":              "docker_host",
+	"push_token":                  "pushToken",
+}
+
+// monitorDNSResolveTypes lists the DNS record types a dns monitor can resolve.
+var monitorDNSResolveTypes = []string{"A", "AAAA", "CAA", "CNAME", "MX", "NS", "PTR", "SOA", "SRV", "TXT"}
+
+// monitorDatabaseConnectionPrefixes lists the connection string prefixes accepted by
+// each database monitor type. SQL Server takes an ADO.NET style connection string.
+var monitorDatabaseConnectionPrefixes = map[client.MonitorType][]string{
+	client.MonitorTypePostgres:  {"postgres://", "postgresql://"},
+	client.MonitorTypeMySQL:     {"mysql://"},
+	client.MonitorTypeSQLServer: {"Server=", "sqlserver://", "mssql://"},
+	client.MonitorTypeMongoDB:   {"mongodb://", "mongodb+srv://"},
+	client.MonitorTypeRedis:     {"redis://", "rediss://"},
+}
+
+// monitorAuthMethodAttributes lists the attributes each authentication method requires.
+var monitorAuthMethodAttributes = map[client.AuthMethod][]string{
+	client.AuthMethodBasic:    {"basic_auth_user", "basic_auth_pass"},
+	client.AuthMethodNTLM:     {"basic_auth_user", "basic_auth_pass"},
+	client.AuthMethodMTLS:     {"tls_cert", "tls_key"},
+	client.AuthMethodOAuth2CC: {"oauth_token_url", "oauth_client_id", "oauth_client_secret"},
+}
 
 func NewMonitorResource() resource.Resource {
 	return &MonitorResource{}
@@ -31,31 +145,106 @@ func NewMonitorResource() resource.Resource {
 // MonitorResource defines the resource implementation.
 type MonitorResource struct {
 	client *client.Client
+
+	// baseURL is the Uptime Kuma URL push URLs are built from.
+	baseURL string
+
+	// defaultNotificationIDs are applied when notification_ids is not configured.
+	defaultNotificationIDs []int64
 }
 
 // MonitorResourceModel describes the resource data model.
 type MonitorResourceModel struct {
-	ID             types.Int64  `tfsdk:"id"`
-	Type           types.String `tfsdk:"type"`
-	Name           types.String `tfsdk:"name"`
-	Description    types.String `tfsdk:"description"`
-	URL            types.String `tfsdk:"url"`
-	Method         types.String `tfsdk:"method"`
-	Hostname       types.String `tfsdk:"hostname"`
-	Port           types.Int64  `tfsdk:"port"`
-	Interval       types.Int64  `tfsdk:"interval"`
-	RetryInterval  types.Int64  `tfsdk:"retry_interval"`
-	ResendInterval types.Int64  `tfsdk:"resend_interval"`
-	MaxRetries     types.Int64  `tfsdk:"max_retries"`
-	UpsideDown     types.Bool   `tfsdk:"upside_down"`
-	IgnoreTLS      types.Bool   `tfsdk:"ignore_tls"`
-	MaxRedirects   types.Int64  `tfsdk:"max_redirects"`
-	Body           types.String `tfsdk:"body"`
-	Headers        types.String `tfsdk:"headers"`
-	AuthMethod     types.String `tfsdk:"auth_method"`
-	BasicAuthUser  types.String `tfsdk:"basic_auth_user"`
-	BasicAuthPass  types.String `tfsdk:"basic_auth_pass"`
-	Keyword        types.String `tfsdk:"keyword"`
+	ID             types.Int64       `tfsdk:"id"`
+	Type           types.String      `tfsdk:"type"`
+	Name           types.String      `tfsdk:"name"`
+	ParentID       types.Int64       `tfsdk:"parent_id"`
+	Active         types.Bool        `tfsdk:"active"`
+	Description    types.String      `tfsdk:"description"`
+	URL            types.String      `tfsdk:"url"`
+	Method         types.String      `tfsdk:"method"`
+	Hostname       types.String      `tfsdk:"hostname"`
+	Port           types.Int64       `tfsdk:"port"`
+	Interval       types.Int64       `tfsdk:"interval"`
+	RetryInterval  types.Int64       `tfsdk:"retry_interval"`
+	ResendInterval types.Int64       `tfsdk:"resend_interval"`
+	MaxRetries     types.Int64       `tfsdk:"max_retries"`
+	UpsideDown     types.Bool        `tfsdk:"upside_down"`
+	IgnoreTLS      types.Bool        `tfsdk:"ignore_tls"`
+	MaxRedirects   types.Int64       `tfsdk:"max_redirects"`
+	Body           types.String      `tfsdk:"body"`
+	Headers        types.String      `tfsdk:"headers"`
+	AuthMethod     types.String      `tfsdk:"auth_method"`
+	BasicAuthUser  types.String      `tfsdk:"basic_auth_user"`
+	BasicAuthPass  types.String      `tfsdk:"basic_auth_pass"`
+	Keyword        types.String      `tfsdk:"keyword"`
+	Tags           []MonitorTagModel `tfsdk:"tags"`
+
+	AcceptedStatusCodes []types.String `tfsdk:"accepted_status_codes"`
+	HTTPBodyEncoding    types.String   `tfsdk:"http_body_encoding"`
+	Timeout             types.Int64    `tfsdk:"timeout"`
+	ProxyID             types.Int64    `tfsdk:"proxy_id"`
+	ExpiryNotification  types.Bool     `tfsdk:"expiry_notification"`
+	InvertKeyword       types.Bool     `tfsdk:"invert_keyword"`
+
+	TLSCert           types.String `tfsdk:"tls_cert"`
+	TLSKey            types.String `tfsdk:"tls_key"`
+	TLSCa             types.String `tfsdk:"tls_ca"`
+	OAuthAuthMethod   types.String `tfsdk:"oauth_auth_method"`
+	OAuthTokenURL     types.String `tfsdk:"oauth_token_url"`
+	OAuthClientID     types.String `tfsdk:"oauth_client_id"`
+	OAuthClientSecret types.String `tfsdk:"oauth_client_secret"`
+	OAuthScopes       types.String `tfsdk:"oauth_scopes"`
+
+	DatabaseConnectionString types.String `tfsdk:"database_connection_string"`
+	DatabaseQuery            types.String `tfsdk:"database_query"`
+
+	MQTTUsername       types.String `tfsdk:"mqtt_username"`
+	MQTTPassword       types.String `tfsdk:"mqtt_password"`
+	MQTTTopic          types.String `tfsdk:"mqtt_topic"`
+	MQTTSuccessMessage types.String `tfsdk:"mqtt_success_message"`
+
+	KafkaProducerBrokers                []types.String                 `tfsdk:"kafka_producer_brokers"`
+	KafkaProducerTopic                  types.String                   `tfsdk:"kafka_producer_topic"`
+	KafkaProducerMessage                types.String                   `tfsdk:"kafka_producer_message"`
+	KafkaProducerSSL                    types.Bool                     `tfsdk:"kafka_producer_ssl"`
+	KafkaProducerAllowAutoTopicCreation types.Bool                     `tfsdk:"kafka_producer_allow_auto_topic_creation"`
+	KafkaProducerSASLOptions            *KafkaProducerSASLOptionsModel `tfsdk:"kafka_producer_sasl_options"`
+
+	DNSResolveServer       types.String `tfsdk:"dns_resolve_server"`
+	DNSResolveType         types.String `tfsdk:"dns_resolve_type"`
+	PacketSize             types.Int64  `tfsdk:"packet_size"`
+	RadiusUsername         types.String `tfsdk:"radius_username"`
+	RadiusPassword         types.String `tfsdk:"radius_password"`
+	RadiusSecret           types.String `tfsdk:"radius_secret"`
+	RadiusCalledStationID  types.String `tfsdk:"radius_called_station_id"`
+	RadiusCallingStationID types.String `tfsdk:"radius_calling_station_id"`
+	Game                   types.String `tfsdk:"game"`
+	GamedigGivenPortOnly   types.Bool   `tfsdk:"gamedig_given_port_only"`
+
+	JSONPath      types.String `tfsdk:"json_path"`
+	ExpectedValue types.String `tfsdk:"expected_value"`
+
+	DockerContainer types.String `tfsdk:"docker_container"`
+	DockerHostID    types.Int64  `tfsdk:"docker_host_id"`
+
+	PushToken types.String `tfsdk:"push_token"`
+	PushURL   types.String `tfsdk:"push_url"`
+
+	NotificationIDs types.Set `tfsdk:"notification_ids"`
+}
+
+// KafkaProducerSASLOptionsModel describes the SASL options of a kafka-producer monitor.
+type KafkaProducerSASLOptionsModel struct {
+	Mechanism types.String `tfsdk:"mechanism"`
+	Username  types.String `tfsdk:"username"`
+	Password  types.String `tfsdk:"password"`
+}
+
+// MonitorTagModel describes a tag attached to a monitor.
+type MonitorTagModel struct {
+	TagID types.Int64  `tfsdk:"tag_id"`
+	Value types.String `tfsdk:"value"`
 }
 
 func (r *MonitorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -76,13 +265,23 @@ func (r *MonitorResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "Monitor type (http, ping, port, etc.).",
+				MarkdownDescription: "Monitor type (group, http, ping, port, etc.).",
 				Required:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Monitor name.",
 				Required:            true,
 			},
+			"parent_id": schema.Int64Attribute{
+				MarkdownDescription: "Identifier of the group monitor this monitor is nested in.",
+				Optional:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the monitor is active. Set to `false` to pause it.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Monitor description.",
 				Required:            true,
@@ -94,14 +293,19 @@ func (r *MonitorResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"method": schema.StringAttribute{
 				MarkdownDescription: "HTTP method (GET, POST, etc.) for http monitors.",
 				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("GET"),
 			},
 			"hostname": schema.StringAttribute{
 				MarkdownDescription: "Hostname for ping, port, etc. monitors.",
 				Optional:            true,
 			},
 			"port": schema.Int64Attribute{
-				MarkdownDescription: "Port number for port monitors.",
+				MarkdownDescription: "Port number for port, dns, radius, gamedig, steam and mqtt monitors.",
 				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
 			},
 			"interval": schema.Int64Attribute{
 				MarkdownDescription: "Check interval in seconds.",
@@ -130,14 +334,66 @@ func (r *MonitorResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"upside_down": schema.BoolAttribute{
 				MarkdownDescription: "Invert status (treat DOWN as UP and vice versa).",
 				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
 			},
 			"ignore_tls": schema.BoolAttribute{
 				MarkdownDescription: "Ignore TLS/SSL errors.",
 				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
 			},
 			"max_redirects": schema.Int64Attribute{
-				MarkdownDescription: "Maximum number of redirects to follow.",
+				MarkdownDescription: "Maximum number of redirects to follow. Set to 0 to disable redirects.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(10),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"accepted_status_codes": schema.ListAttribute{
+				MarkdownDescription: "HTTP status codes treated as up, either single codes such as `401` or ranges such as `200-299`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default: listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("200-299"),
+				})),
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(monitorStatusCodeRegexp, "must be a status code such as 401 or a range such as 200-299"),
+					),
+				},
+			},
+			"http_body_encoding": schema.StringAttribute{
+				MarkdownDescription: "Encoding of the request body (json, form, xml).",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("json"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("json", "form", "xml"),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "Request timeout in seconds.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(48),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"proxy_id": schema.Int64Attribute{
+				MarkdownDescription: "Identifier of the proxy used for http monitors.",
+				Optional:            true,
+			},
+			"expiry_notification": schema.BoolAttribute{
+				MarkdownDescription: "Notify when the TLS certificate is about to expire.",
 				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
 			},
 			"body": schema.StringAttribute{
 				MarkdownDescription: "Request body for http monitors.",
@@ -148,8 +404,17 @@ func (r *MonitorResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:            true,
 			},
 			"auth_method": schema.StringAttribute{
-				MarkdownDescription: "Authentication method (basic, ntlm, mtls).",
+				MarkdownDescription: "Authentication method (basic, ntlm, mtls, oauth2-cc).",
 				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.AuthMethodNone),
+						string(client.AuthMethodBasic),
+						string(client.AuthMethodNTLM),
+						string(client.AuthMethodMTLS),
+						string(client.AuthMethodOAuth2CC),
+					),
+				},
 			},
 			"basic_auth_user": schema.StringAttribute{
 				MarkdownDescription: "Basic auth username.",
@@ -160,102 +425,400 @@ func (r *MonitorResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"tls_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate for mtls authentication.",
+				Optional:            true,
+			},
+			"tls_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of `tls_cert` for mtls authentication.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"tls_ca": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificate used to verify the server for mtls authentication.",
+				Optional:            true,
+			},
+			"oauth_auth_method": schema.StringAttribute{
+				MarkdownDescription: "How the client credentials are sent to the token endpoint (client_secret_basic, client_secret_post) for oauth2-cc authentication.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("client_secret_basic"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("client_secret_basic", "client_secret_post"),
+				},
+			},
+			"oauth_token_url": schema.StringAttribute{
+				MarkdownDescription: "Token endpoint URL for oauth2-cc authentication.",
+				Optional:            true,
+			},
+			"oauth_client_id": schema.StringAttribute{
+				MarkdownDescription: "Client ID for oauth2-cc authentication.",
+				Optional:            true,
+			},
+			"oauth_client_secret": schema.StringAttribute{
+				MarkdownDescription: "Client secret for oauth2-cc authentication.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"oauth_scopes": schema.StringAttribute{
+				MarkdownDescription: "Space separated scopes requested for oauth2-cc authentication.",
+				Optional:            true,
+			},
+			"database_connection_string": schema.StringAttribute{
+				MarkdownDescription: "Connection string for postgres, mysql, sqlserver, mongodb and redis monitors. Its scheme must match the monitor type, for example `postgres://` for postgres monitors.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"database_query": schema.StringAttribute{
+				MarkdownDescription: "Query run by postgres, mysql, sqlserver and mongodb monitors.",
+				Optional:            true,
+			},
+			"mqtt_username": schema.StringAttribute{
+				MarkdownDescription: "Username for mqtt monitors.",
+				Optional:            true,
+			},
+			"mqtt_password": schema.StringAttribute{
+				MarkdownDescription: "Password for mqtt monitors.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"mqtt_topic": schema.StringAttribute{
+				MarkdownDescription: "Topic subscribed to by mqtt monitors.",
+				Optional:            true,
+			},
+			"mqtt_success_message": schema.StringAttribute{
+				MarkdownDescription: "Message expected on the topic for mqtt monitors. Any message is accepted when omitted.",
+				Optional:            true,
+			},
+			"kafka_producer_brokers": schema.ListAttribute{
+				MarkdownDescription: "Broker addresses (host:port) for kafka-producer monitors.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"kafka_producer_topic": schema.StringAttribute{
+				MarkdownDescription: "Topic written to by kafka-producer monitors.",
+				Optional:            true,
+			},
+			"kafka_producer_message": schema.StringAttribute{
+				MarkdownDescription: "Message written by kafka-producer monitors.",
+				Optional:            true,
+			},
+			"kafka_producer_ssl": schema.BoolAttribute{
+				MarkdownDescription: "Connect to the brokers over TLS for kafka-producer monitors.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"kafka_producer_allow_auto_topic_creation": schema.BoolAttribute{
+				MarkdownDescription: "Let kafka-producer monitors create the topic when it does not exist.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"kafka_producer_sasl_options": schema.SingleNestedAttribute{
+				MarkdownDescription: "SASL authentication for kafka-producer monitors.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"mechanism": schema.StringAttribute{
+						MarkdownDescription: "SASL mechanism (plain, scram-sha-256, scram-sha-512).",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("plain", "scram-sha-256", "scram-sha-512"),
+						},
+					},
+					"username": schema.StringAttribute{
+						MarkdownDescription: "SASL username.",
+						Required:            true,
+					},
+					"password": schema.StringAttribute{
+						MarkdownDescription: "SASL password.",
+						Required:            true,
+						Sensitive:           true,
+					},
+				},
+			},
+			"json_path": schema.StringAttribute{
+				MarkdownDescription: "JSONata or JSONPath expression evaluated against the response of json-query monitors, such as `$.status`.",
+				Optional:            true,
+				Validators: []validator.String{
+					jsonQueryExpressionValidator{},
+				},
+			},
+			"expected_value": schema.StringAttribute{
+				MarkdownDescription: "Value the `json_path` expression must evaluate to for json-query monitors.",
+				Optional:            true,
+			},
+			"docker_container": schema.StringAttribute{
+				MarkdownDescription: "Name or ID of the container watched by docker monitors.",
+				Optional:            true,
+			},
+			"docker_host_id": schema.Int64Attribute{
+				MarkdownDescription: "Identifier of the Uptime Kuma docker host the container runs on, for docker monitors.",
+				Optional:            true,
+			},
+			"push_token": schema.StringAttribute{
+				MarkdownDescription: "Token push monitors are reported to. Generated when omitted, unless Uptime Kuma assigns its own on create; set it to pin or rotate the token.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(monitorPushTokenRegexp, "must only contain letters, digits, hyphens and underscores"),
+				},
+			},
+			"push_url": schema.StringAttribute{
+				MarkdownDescription: "URL push monitors are reported to, built from the provider `base_url` and `push_token`.",
+				Computed:            true,
+			},
+			"dns_resolve_server": schema.StringAttribute{
+				MarkdownDescription: "Resolver queried by dns monitors.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("1.1.1.1"),
+			},
+			"dns_resolve_type": schema.StringAttribute{
+				MarkdownDescription: "Record type resolved by dns monitors (A, AAAA, CAA, CNAME, MX, NS, PTR, SOA, SRV, TXT).",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("A"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(monitorDNSResolveTypes...),
+				},
+			},
+			"packet_size": schema.Int64Attribute{
+				MarkdownDescription: "Packet size in bytes for ping monitors.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(56),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65500),
+				},
+			},
+			"radius_username": schema.StringAttribute{
+				MarkdownDescription: "Username for radius monitors.",
+				Optional:            true,
+			},
+			"radius_password": schema.StringAttribute{
+				MarkdownDescription: "Password for radius monitors.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"radius_secret": schema.StringAttribute{
+				MarkdownDescription: "Shared secret for radius monitors.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"radius_called_station_id": schema.StringAttribute{
+				MarkdownDescription: "Called station ID for radius monitors.",
+				Optional:            true,
+			},
+			"radius_calling_station_id": schema.StringAttribute{
+				MarkdownDescription: "Calling station ID for radius monitors.",
+				Optional:            true,
+			},
+			"game": schema.StringAttribute{
+				MarkdownDescription: "GameDig game identifier for gamedig monitors, such as `minecraft`.",
+				Optional:            true,
+			},
+			"gamedig_given_port_only": schema.BoolAttribute{
+				MarkdownDescription: "Only query the given port for gamedig monitors.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"keyword": schema.StringAttribute{
 				MarkdownDescription: "Keyword to search for in response.",
 				Optional:            true,
 			},
+			"invert_keyword": schema.BoolAttribute{
+				MarkdownDescription: "Treat the monitor as down when the keyword is found.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"tags": schema.SetNestedAttribute{
+				MarkdownDescription: "Tags attached to the monitor. Omit to leave the monitor's tags unmanaged; set to an empty set to remove all tags.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"tag_id": schema.Int64Attribute{
+							MarkdownDescription: "Tag identifier.",
+							Required:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Tag value.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+					},
+				},
+			},
+			"notification_ids": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the notifications sent when the monitor changes state. Defaults to the provider's `default_notification_ids` when omitted; set to an empty set to disable notifications.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
 			// where the API provides defaults if not specified by the user.
 			// Also added periods to all descriptions proactively for 'godot'.
 		},
 	}
 }
 
+func (r *MonitorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var monitorType, authMethod, connectionString types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &monitorType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("auth_method"), &authMethod)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("database_connection_string"), &connectionString)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !monitorType.IsNull() && !monitorType.IsUnknown() {
+		requireMonitorAttributes(ctx, req.Config, "type", monitorType.ValueString(), monitorTypeAttributes[client.MonitorType(monitorType.ValueString())], &resp.Diagnostics)
+
+		prefixes := monitorDatabaseConnectionPrefixes[client.MonitorType(monitorType.ValueString())]
+		if len(prefixes) > 0 && !connectionString.IsNull() && !connectionString.IsUnknown() && !hasAnyPrefixFold(connectionString.ValueString(), prefixes) {
+			// The connection string is sensitive, so it is not repeated in the error.
+			resp.Diagnostics.AddAttributeError(
+				path.Root("database_connection_string"),
+				"Invalid Monitor Attribute",
+				fmt.Sprintf("database_connection_string must start with %s when type is %q.", quoteJoin(prefixes, " or "), monitorType.ValueString()),
+			)
+		}
+	}
+
+	if !authMethod.IsNull() && !authMethod.IsUnknown() {
+		requireMonitorAttributes(ctx, req.Config, "auth_method", authMethod.ValueString(), monitorAuthMethodAttributes[client.AuthMethod(authMethod.ValueString())], &resp.Diagnostics)
+	}
+}
+
 func (r *MonitorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	providerData, ok := req.ProviderData.(*UptimeKumaProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *UptimeKumaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.baseURL = providerData.BaseURL
+	r.defaultNotificationIDs = providerData.DefaultNotificationIDs
 }
 
-func (r *MonitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data MonitorResourceModel
+func (r *MonitorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the monitor is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
 
-	// Read Terraform plan data into the model.
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	r.modifyPlanNotificationIDs(ctx, req, resp)
+	r.modifyPlanPush(ctx, req, resp)
+}
 
-	if resp.Diagnostics.HasError() {
+// modifyPlanNotificationIDs plans notification_ids when it is not configured.
+func (r *MonitorResource) modifyPlanNotificationIDs(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var configured types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("notification_ids"), &configured)...)
+
+	if resp.Diagnostics.HasError() || !configured.IsNull() {
 		return
 	}
 
-	// Prepare the API request
-	monitor := &client.Monitor{
-		Type:           client.MonitorType(data.Type.ValueString()),
-		Name:           data.Name.ValueString(),
-		Description:    data.Description.ValueString(),
-		Interval:       int(data.Interval.ValueInt64()),
-		RetryInterval:  int(data.RetryInterval.ValueInt64()),
-		ResendInterval: int(data.ResendInterval.ValueInt64()),
-		MaxRetries:     int(data.MaxRetries.ValueInt64()),
-		UpsideDown:     data.UpsideDown.ValueBool(),
-		IgnoreTLS:      data.IgnoreTLS.ValueBool(),
+	// Fall back to the provider default, then to the notifications already bound
+	// to the monitor, so an omitted attribute does not show a perpetual diff.
+	var notificationIDs types.Set
+	switch {
+	case r.defaultNotificationIDs != nil:
+		var diags diag.Diagnostics
+		notificationIDs, diags = types.SetValueFrom(ctx, types.Int64Type, r.defaultNotificationIDs)
+		resp.Diagnostics.Append(diags...)
+	case !req.State.Raw.IsNull():
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("notification_ids"), &notificationIDs)...)
+	default:
+		notificationIDs = types.SetValueMust(types.Int64Type, []attr.Value{})
 	}
 
-	// Set optional fields.
-	if !data.URL.IsNull() {
-		monitor.URL = data.URL.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.Method.IsNull() {
-		monitor.Method = data.Method.ValueString()
-	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("notification_ids"), notificationIDs)...)
+}
 
-	if !data.Hostname.IsNull() {
-		monitor.Hostname = data.Hostname.ValueString()
-	}
+// modifyPlanPush plans push_token and push_url. Only push monitors get a token,
+// and the URL follows the planned token.
+func (r *MonitorResource) modifyPlanPush(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var monitorType, pushToken, configured types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &monitorType)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("push_token"), &pushToken)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("push_token"), &configured)...)
 
-	if !data.Port.IsNull() {
-		monitor.Port = int(data.Port.ValueInt64())
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.MaxRedirects.IsNull() {
-		monitor.MaxRedirects = int(data.MaxRedirects.ValueInt64())
+	switch {
+	case monitorType.IsUnknown():
+		if configured.IsNull() {
+			pushToken = types.StringUnknown()
+		}
+	case monitorType.ValueString() != string(client.MonitorTypePush):
+		if configured.IsNull() {
+			pushToken = types.StringNull()
+		}
+	case pushToken.IsNull():
+		// A monitor changed to the push type needs a new token.
+		pushToken = types.StringUnknown()
 	}
 
-	if !data.Body.IsNull() {
-		monitor.Body = data.Body.ValueString()
+	pushURL := types.StringUnknown()
+	if !monitorType.IsUnknown() && !pushToken.IsUnknown() {
+		pushURL = r.pushURL(monitorType.ValueString(), pushToken.ValueString())
 	}
 
-	if !data.Headers.IsNull() {
-		monitor.Headers = data.Headers.ValueString()
-	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("push_token"), pushToken)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("push_url"), pushURL)...)
+}
 
-	if !data.AuthMethod.IsNull() {
-		monitor.AuthMethod = client.AuthMethod(data.AuthMethod.ValueString())
-	}
+func (r *MonitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MonitorResourceModel
 
-	if !data.BasicAuthUser.IsNull() {
-		monitor.BasicAuthUser = data.BasicAuthUser.ValueString()
+	// Read Terraform plan data into the model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.BasicAuthPass.IsNull() {
-		monitor.BasicAuthPass = data.BasicAuthPass.ValueString()
+	ctx = maskDatabaseConnectionString(ctx, data.DatabaseConnectionString)
+
+	generatedPushToken := data.PushToken.IsUnknown()
+	if err := r.setPushToken(&data); err != nil {
+		resp.Diagnostics.AddError("Unable to Generate Push Token", err.Error())
+		return
 	}
 
-	if !data.Keyword.IsNull() {
-		monitor.Keyword = data.Keyword.ValueString()
+	// Prepare the API request.
+	monitor, diags := newMonitorFromModel(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Create the monitor.
@@ -266,13 +829,31 @@ func (r *MonitorResource) Create(ctx context.Context, req resource.CreateRequest
 
 	createdMonitor, err := r.client.CreateMonitor(ctx, monitor)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create monitor: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create monitor", err)
 		return
 	}
 
 	// Update Terraform state.
 	data.ID = types.Int64Value(int64(createdMonitor.ID))
 
+	// The API may not store the push token it was sent, so keep the one it assigned.
+	if data.Type.ValueString() == string(client.MonitorTypePush) {
+		r.readPushToken(ctx, &data, generatedPushToken, &resp.Diagnostics)
+	}
+
+	// Attach tags to the new monitor.
+	if err := r.syncMonitorTags(ctx, createdMonitor.ID, nil, data.Tags); err != nil {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to tag monitor %d", createdMonitor.ID), err)
+	}
+
+	// New monitors start active, so pause it if requested.
+	if !data.Active.ValueBool() {
+		if err := r.client.PauseMonitor(ctx, createdMonitor.ID); err != nil {
+			addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to pause monitor %d", createdMonitor.ID), err)
+			data.Active = types.BoolValue(true)
+		}
+	}
+
 	// Save data into Terraform state.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -287,128 +868,69 @@ func (r *MonitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	ctx = maskDatabaseConnectionString(ctx, data.DatabaseConnectionString)
+
 	monitorID := int(data.ID.ValueInt64())
 	tflog.Debug(ctx, "Reading monitor from API", map[string]interface{}{"id": monitorID})
 
 	// Read the monitor from the API.
 	monitor, err := r.client.GetMonitor(ctx, monitorID)
 
-	// --- START FIX for Read Error Handling ---
 	if err != nil {
-		// This is a basic example assuming a string check:
-		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "404") {
-			// Resource is gone upstream, remove it from state
+		if errors.Is(err, client.ErrNotFound) {
+			// Resource is gone upstream, remove it from state.
 			tflog.Warn(ctx, "Monitor not found, removing from state", map[string]interface{}{"id": monitorID})
-			resp.State.RemoveResource(ctx) // Tell Terraform the resource no longer exists
-			return                         // Stop processing
+			resp.State.RemoveResource(ctx)
+			return
 		}
 
-		// For other errors, report them
-		resp.Diagnostics.AddError(
-			"Client Error",
-			fmt.Sprintf("Unable to read monitor %d: %s", monitorID, err),
-		)
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to read monitor %d", monitorID), err)
 		return
 	}
 
-	// If no error, update the data model from the successful API response.
-	data.ID = types.Int64Value(int64(monitor.ID))
-	data.Type = types.StringValue(string(monitor.Type))
-	data.Name = types.StringValue(monitor.Name)
-	data.Description = types.StringValue(monitor.Description)
-	data.URL = types.StringValue(monitor.URL)
-	data.Method = types.StringValue(monitor.Method)
-	data.Hostname = types.StringValue(monitor.Hostname)
-	// Handle potential nil or zero values appropriately when converting from API type to TF type.
-	data.Port = types.Int64Value(int64(monitor.Port))
-	data.Interval = types.Int64Value(int64(monitor.Interval))
-	data.RetryInterval = types.Int64Value(int64(monitor.RetryInterval))
-	data.ResendInterval = types.Int64Value(int64(monitor.ResendInterval))
-	data.MaxRetries = types.Int64Value(int64(monitor.MaxRetries))
-	data.UpsideDown = types.BoolValue(monitor.UpsideDown)
-	data.IgnoreTLS = types.BoolValue(monitor.IgnoreTLS)
-	data.MaxRedirects = types.Int64Value(int64(monitor.MaxRedirects))
-	data.Body = types.StringValue(monitor.Body)
-	data.Headers = types.StringValue(monitor.Headers)
-	data.AuthMethod = types.StringValue(string(monitor.AuthMethod))
-	data.BasicAuthUser = types.StringValue(monitor.BasicAuthUser)
-	// Do not read back sensitive values like passwords unless necessary and handled correctly.
-	// data.BasicAuthPass = types.StringValue(monitor.BasicAuthPass).
-	data.Keyword = types.StringValue(monitor.Keyword)
+	resp.Diagnostics.Append(updateMonitorModel(ctx, &data, monitor)...)
+	data.PushURL = r.pushURL(data.Type.ValueString(), data.PushToken.ValueString())
 
 	// Save updated data into Terraform state.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *MonitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data MonitorResourceModel
+	var data, state MonitorResourceModel
 
-	// Read Terraform plan data into the model.
+	// Read Terraform plan and prior state data into the models.
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx = maskDatabaseConnectionString(ctx, data.DatabaseConnectionString)
+
 	monitorID := int(data.ID.ValueInt64())
 
-	// Prepare the API requester.
-	monitor := &client.Monitor{
-		Type:           client.MonitorType(data.Type.ValueString()),
-		Name:           data.Name.ValueString(),
-		Description:    data.Description.ValueString(),
-		Interval:       int(data.Interval.ValueInt64()),
-		RetryInterval:  int(data.RetryInterval.ValueInt64()),
-		ResendInterval: int(data.ResendInterval.ValueInt64()),
-		MaxRetries:     int(data.MaxRetries.ValueInt64()),
-		UpsideDown:     data.UpsideDown.ValueBool(),
-		IgnoreTLS:      data.IgnoreTLS.ValueBool(),
-	}
-
-	// Set optional field.
-	if !data.URL.IsNull() {
-		monitor.URL = data.URL.ValueString()
-	}
+	clearedFields, diags := nullFields(ctx, req.Plan, req.State, monitorNullableFields)
+	resp.Diagnostics.Append(diags...)
 
-	if !data.Method.IsNull() {
-		monitor.Method = data.Method.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.Hostname.IsNull() {
-		monitor.Hostname = data.Hostname.ValueString()
+	if err := r.setPushToken(&data); err != nil {
+		resp.Diagnostics.AddError("Unable to Generate Push Token", err.Error())
+		return
 	}
 
-	if !data.Port.IsNull() {
-		monitor.Port = int(data.Port.ValueInt64())
-	}
+	// Prepare the API request.
+	monitor, diags := newMonitorFromModel(ctx, &data)
+	resp.Diagnostics.Append(diags...)
 
-	if !data.MaxRedirects.IsNull() {
-		monitor.MaxRedirects = int(data.MaxRedirects.ValueInt64())
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.Body.IsNull() {
-		monitor.Body = data.Body.ValueString()
-	}
-
-	if !data.Headers.IsNull() {
-		monitor.Headers = data.Headers.ValueString()
-	}
-
-	if !data.AuthMethod.IsNull() {
-		monitor.AuthMethod = client.AuthMethod(data.AuthMethod.ValueString())
-	}
-
-	if !data.BasicAuthUser.IsNull() {
-		monitor.BasicAuthUser = data.BasicAuthUser.ValueString()
-	}
-
-	if !data.BasicAuthPass.IsNull() {
-		monitor.BasicAuthPass = data.BasicAuthPass.ValueString()
-	}
-
-	if !data.Keyword.IsNull() {
-		monitor.Keyword = data.Keyword.ValueString()
-	}
+	monitor.NullFields = clearedFields
 
 	// Update the monitor.
 	tflog.Info(ctx, "Updating monitor", map[string]interface{}{
@@ -418,10 +940,33 @@ func (r *MonitorResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	_, err := r.client.UpdateMonitor(ctx, monitorID, monitor)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update monitor %d: %s", monitorID, err))
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to update monitor %d", monitorID), err)
 		return
 	}
 
+	// Add and remove only the tags that changed.
+	if data.Tags != nil {
+		if err := r.syncMonitorTags(ctx, monitorID, state.Tags, data.Tags); err != nil {
+			addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to update tags of monitor %d", monitorID), err)
+			return
+		}
+	}
+
+	// Pause or resume the monitor when active changes.
+	if data.Active.ValueBool() != state.Active.ValueBool() {
+		if data.Active.ValueBool() {
+			if err := r.client.ResumeMonitor(ctx, monitorID); err != nil {
+				addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to resume monitor %d", monitorID), err)
+				return
+			}
+		} else {
+			if err := r.client.PauseMonitor(ctx, monitorID); err != nil {
+				addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to pause monitor %d", monitorID), err)
+				return
+			}
+		}
+	}
+
 	// Save updated data into Terraform state.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -438,14 +983,22 @@ func (r *MonitorResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	monitorID := int(data.ID.ValueInt64())
 
+	// Children still in a group are moved out before it is deleted.
+	if data.Type.ValueString() == string(client.MonitorTypeGroup) {
+		if err := r.releaseGroupChildren(ctx, monitorID, data.ParentID); err != nil {
+			addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to move monitors out of group %d", monitorID), err)
+			return
+		}
+	}
+
 	// Delete the monitor.
 	tflog.Info(ctx, "Deleting monitor", map[string]interface{}{
 		"id": monitorID,
 	})
 
 	err := r.client.DeleteMonitor(ctx, monitorID)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete monitor %d: %s", monitorID, err))
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to delete monitor %d", monitorID), err)
 		return
 	}
 }
@@ -464,3 +1017,607 @@ func (r *MonitorResource) ImportState(ctx context.Context, req resource.ImportSt
 	// Set the ID in the state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// newMonitorFromModel builds the API representation of a monitor from the Terraform model.
+func newMonitorFromModel(ctx context.Context, data *MonitorResourceModel) (*client.Monitor, diag.Diagnostics) {
+	monitor := &client.Monitor{
+		Type:               client.MonitorType(data.Type.ValueString()),
+		Name:               data.Name.ValueString(),
+		Description:        data.Description.ValueString(),
+		Interval:           int(data.Interval.ValueInt64()),
+		RetryInterval:      int(data.RetryInterval.ValueInt64()),
+		ResendInterval:     int(data.ResendInterval.ValueInt64()),
+		MaxRetries:         int(data.MaxRetries.ValueInt64()),
+		UpsideDown:         data.UpsideDown.ValueBool(),
+		IgnoreTLS:          data.IgnoreTLS.ValueBool(),
+		MaxRedirects:       int(data.MaxRedirects.ValueInt64()),
+		HTTPBodyEncoding:   data.HTTPBodyEncoding.ValueString(),
+		Timeout:            int(data.Timeout.ValueInt64()),
+		ExpiryNotification: data.ExpiryNotification.ValueBool(),
+		InvertKeyword:      data.InvertKeyword.ValueBool(),
+	}
+
+	// Set optional fields.
+	if !data.ParentID.IsNull() {
+		parentID := int(data.ParentID.ValueInt64())
+		monitor.Parent = &parentID
+	}
+
+	if !data.URL.IsNull() {
+		monitor.URL = data.URL.ValueString()
+	}
+
+	if !data.Method.IsNull() {
+		monitor.Method = data.Method.ValueString()
+	}
+
+	if !data.Hostname.IsNull() {
+		monitor.Hostname = data.Hostname.ValueString()
+	}
+
+	if !data.Port.IsNull() {
+		monitor.Port = int(data.Port.ValueInt64())
+	}
+
+	if !data.Body.IsNull() {
+		monitor.Body = data.Body.ValueString()
+	}
+
+	if !data.Headers.IsNull() {
+		monitor.Headers = data.Headers.ValueString()
+	}
+
+	if !data.AuthMethod.IsNull() {
+		monitor.AuthMethod = client.AuthMethod(data.AuthMethod.ValueString())
+	}
+
+	if !data.BasicAuthUser.IsNull() {
+		monitor.BasicAuthUser = data.BasicAuthUser.ValueString()
+	}
+
+	if !data.BasicAuthPass.IsNull() {
+		monitor.BasicAuthPass = data.BasicAuthPass.ValueString()
+	}
+
+	if !data.Keyword.IsNull() {
+		monitor.Keyword = data.Keyword.ValueString()
+	}
+
+	if !data.TLSCert.IsNull() {
+		monitor.TLSCert = data.TLSCert.ValueString()
+	}
+
+	if !data.TLSKey.IsNull() {
+		monitor.TLSKey = data.TLSKey.ValueString()
+	}
+
+	if !data.TLSCa.IsNull() {
+		monitor.TLSCa = data.TLSCa.ValueString()
+	}
+
+	if !data.OAuthAuthMethod.IsNull() {
+		monitor.OAuthAuthMethod = data.OAuthAuthMethod.ValueString()
+	}
+
+	if !data.OAuthTokenURL.IsNull() {
+		monitor.OAuthTokenURL = data.OAuthTokenURL.ValueString()
+	}
+
+	if !data.OAuthClientID.IsNull() {
+		monitor.OAuthClientID = data.OAuthClientID.ValueString()
+	}
+
+	if !data.OAuthClientSecret.IsNull() {
+		monitor.OAuthClientSecret = data.OAuthClientSecret.ValueString()
+	}
+
+	if !data.OAuthScopes.IsNull() {
+		monitor.OAuthScopes = data.OAuthScopes.ValueString()
+	}
+
+	if !data.DatabaseConnectionString.IsNull() {
+		monitor.DatabaseConnectionString = data.DatabaseConnectionString.ValueString()
+	}
+
+	if !data.DatabaseQuery.IsNull() {
+		monitor.DatabaseQuery = data.DatabaseQuery.ValueString()
+	}
+
+	if !data.MQTTUsername.IsNull() {
+		monitor.MQTTUsername = data.MQTTUsername.ValueString()
+	}
+
+	if !data.MQTTPassword.IsNull() {
+		monitor.MQTTPassword = data.MQTTPassword.ValueString()
+	}
+
+	if !data.MQTTTopic.IsNull() {
+		monitor.MQTTTopic = data.MQTTTopic.ValueString()
+	}
+
+	if !data.MQTTSuccessMessage.IsNull() {
+		monitor.MQTTSuccessMessage = data.MQTTSuccessMessage.ValueString()
+	}
+
+	for _, broker := range data.KafkaProducerBrokers {
+		monitor.KafkaProducerBrokers = append(monitor.KafkaProducerBrokers, broker.ValueString())
+	}
+
+	if !data.KafkaProducerTopic.IsNull() {
+		monitor.KafkaProducerTopic = data.KafkaProducerTopic.ValueString()
+	}
+
+	if !data.KafkaProducerMessage.IsNull() {
+		monitor.KafkaProducerMessage = data.KafkaProducerMessage.ValueString()
+	}
+
+	monitor.KafkaProducerSSL = data.KafkaProducerSSL.ValueBool()
+	monitor.KafkaProducerAllowAutoTopicCreation = data.KafkaProducerAllowAutoTopicCreation.ValueBool()
+
+	if data.KafkaProducerSASLOptions != nil {
+		monitor.KafkaProducerSASLOptions = &client.KafkaProducerSASLOptions{
+			Mechanism: data.KafkaProducerSASLOptions.Mechanism.ValueString(),
+			Username:  data.KafkaProducerSASLOptions.Username.ValueString(),
+			Password:  data.KafkaProducerSASLOptions.Password.ValueString(),
+		}
+	}
+
+	if !data.JSONPath.IsNull() {
+		monitor.JSONPath = data.JSONPath.ValueString()
+	}
+
+	if !data.ExpectedValue.IsNull() {
+		monitor.ExpectedValue = data.ExpectedValue.ValueString()
+	}
+
+	if !data.DockerContainer.IsNull() {
+		monitor.DockerContainer = data.DockerContainer.ValueString()
+	}
+
+	if !data.DockerHostID.IsNull() {
+		monitor.DockerHost = int(data.DockerHostID.ValueInt64())
+	}
+
+	if !data.PushToken.IsNull() && !data.PushToken.IsUnknown() {
+		monitor.PushToken = data.PushToken.ValueString()
+	}
+
+	monitor.DNSResolveServer = data.DNSResolveServer.ValueString()
+	monitor.DNSResolveType = data.DNSResolveType.ValueString()
+	monitor.PacketSize = int(data.PacketSize.ValueInt64())
+
+	if !data.RadiusUsername.IsNull() {
+		monitor.RadiusUsername = data.RadiusUsername.ValueString()
+	}
+
+	if !data.RadiusPassword.IsNull() {
+		monitor.RadiusPassword = data.RadiusPassword.ValueString()
+	}
+
+	if !data.RadiusSecret.IsNull() {
+		monitor.RadiusSecret = data.RadiusSecret.ValueString()
+	}
+
+	if !data.RadiusCalledStationID.IsNull() {
+		monitor.RadiusCalledStationID = data.RadiusCalledStationID.ValueString()
+	}
+
+	if !data.RadiusCallingStationID.IsNull() {
+		monitor.RadiusCallingStationID = data.RadiusCallingStationID.ValueString()
+	}
+
+	if !data.Game.IsNull() {
+		monitor.Game = data.Game.ValueString()
+	}
+
+	monitor.GamedigGivenPortOnly = data.GamedigGivenPortOnly.ValueBool()
+
+	if !data.ProxyID.IsNull() {
+		monitor.ProxyID = int(data.ProxyID.ValueInt64())
+	}
+
+	for _, statusCode := range data.AcceptedStatusCodes {
+		monitor.AcceptedStatusCodes = append(monitor.AcceptedStatusCodes, statusCode.ValueString())
+	}
+
+	notificationIDs, diags := notificationIDsFromSet(ctx, data.NotificationIDs)
+	monitor.NotificationIDList = notificationIDs
+
+	return monitor, diags
+}
+
+// updateMonitorModel copies an API monitor into the Terraform model. Optional
+// attributes the API leaves empty stay null, and attributes with a server side
+// default fall back to it, so unset attributes do not show a perpetual diff.
+func updateMonitorModel(ctx context.Context, data *MonitorResourceModel, monitor *client.Monitor) diag.Diagnostics {
+	data.ID = types.Int64Value(int64(monitor.ID))
+	data.Type = types.StringValue(string(monitor.Type))
+	data.Name = types.StringValue(monitor.Name)
+	// A monitor moved to another group in the UI shows up as a change of parent.
+	if monitor.Parent == nil {
+		data.ParentID = types.Int64Null()
+	} else {
+		data.ParentID = types.Int64Value(int64(*monitor.Parent))
+	}
+	// A monitor paused or resumed in the UI shows up as a change of active.
+	data.Active = types.BoolValue(bool(monitor.Active))
+	data.Description = types.StringValue(monitor.Description)
+	data.URL = stringValueOrNull(data.URL, monitor.URL)
+	data.Method = stringValueOrDefault(monitor.Method, "GET")
+	data.Hostname = stringValueOrNull(data.Hostname, monitor.Hostname)
+	// The API fills in port 53 on monitors created without a port.
+	data.Port = int64ValueOrNullDefault(data.Port, monitor.Port, 53)
+	data.Interval = types.Int64Value(int64(monitor.Interval))
+	data.RetryInterval = types.Int64Value(int64(monitor.RetryInterval))
+	data.ResendInterval = types.Int64Value(int64(monitor.ResendInterval))
+	data.MaxRetries = types.Int64Value(int64(monitor.MaxRetries))
+	data.UpsideDown = types.BoolValue(monitor.UpsideDown)
+	data.IgnoreTLS = types.BoolValue(monitor.IgnoreTLS)
+	data.MaxRedirects = types.Int64Value(int64(monitor.MaxRedirects))
+	data.Body = stringValueOrNull(data.Body, monitor.Body)
+	data.Headers = stringValueOrNull(data.Headers, monitor.Headers)
+	data.AuthMethod = stringValueOrNull(data.AuthMethod, string(monitor.AuthMethod))
+	data.BasicAuthUser = stringValueOrNull(data.BasicAuthUser, monitor.BasicAuthUser)
+	data.Keyword = stringValueOrNull(data.Keyword, monitor.Keyword)
+	// Secrets such as basic_auth_pass, tls_key and oauth_client_secret are kept as configured.
+	data.TLSCert = stringValueOrNull(data.TLSCert, monitor.TLSCert)
+	data.TLSCa = stringValueOrNull(data.TLSCa, monitor.TLSCa)
+	data.OAuthAuthMethod = stringValueOrDefault(monitor.OAuthAuthMethod, "client_secret_basic")
+	data.OAuthTokenURL = stringValueOrNull(data.OAuthTokenURL, monitor.OAuthTokenURL)
+	data.OAuthClientID = stringValueOrNull(data.OAuthClientID, monitor.OAuthClientID)
+	data.OAuthScopes = stringValueOrNull(data.OAuthScopes, monitor.OAuthScopes)
+	data.DatabaseConnectionString = stringValueOrNull(data.DatabaseConnectionString, monitor.DatabaseConnectionString)
+	data.DatabaseQuery = stringValueOrNull(data.DatabaseQuery, monitor.DatabaseQuery)
+	data.MQTTUsername = stringValueOrNull(data.MQTTUsername, monitor.MQTTUsername)
+	data.MQTTTopic = stringValueOrNull(data.MQTTTopic, monitor.MQTTTopic)
+	data.MQTTSuccessMessage = stringValueOrNull(data.MQTTSuccessMessage, monitor.MQTTSuccessMessage)
+	data.KafkaProducerTopic = stringValueOrNull(data.KafkaProducerTopic, monitor.KafkaProducerTopic)
+	data.KafkaProducerMessage = stringValueOrNull(data.KafkaProducerMessage, monitor.KafkaProducerMessage)
+	data.KafkaProducerSSL = types.BoolValue(monitor.KafkaProducerSSL)
+	data.KafkaProducerAllowAutoTopicCreation = types.BoolValue(monitor.KafkaProducerAllowAutoTopicCreation)
+	data.DockerContainer = stringValueOrNull(data.DockerContainer, monitor.DockerContainer)
+	data.DockerHostID = int64ValueOrNull(monitor.DockerHost)
+	data.PushToken = stringValueOrNull(data.PushToken, monitor.PushToken)
+
+	// The API leaves the DNS and ping options empty on monitors created without them.
+	data.DNSResolveServer = stringValueOrDefault(monitor.DNSResolveServer, "1.1.1.1")
+	data.DNSResolveType = stringValueOrDefault(monitor.DNSResolveType, "A")
+	data.PacketSize = int64ValueOrDefault(monitor.PacketSize, 56)
+	data.RadiusUsername = stringValueOrNull(data.RadiusUsername, monitor.RadiusUsername)
+	data.RadiusCalledStationID = stringValueOrNull(data.RadiusCalledStationID, monitor.RadiusCalledStationID)
+	data.RadiusCallingStationID = stringValueOrNull(data.RadiusCallingStationID, monitor.RadiusCallingStationID)
+	data.Game = stringValueOrNull(data.Game, monitor.Game)
+	data.GamedigGivenPortOnly = types.BoolValue(monitor.GamedigGivenPortOnly)
+
+	if len(monitor.KafkaProducerBrokers) > 0 || data.KafkaProducerBrokers != nil {
+		brokers := make([]types.String, 0, len(monitor.KafkaProducerBrokers))
+		for _, broker := range monitor.KafkaProducerBrokers {
+			brokers = append(brokers, types.StringValue(broker))
+		}
+		data.KafkaProducerBrokers = brokers
+	}
+
+	// The API reports a "None" mechanism when SASL is disabled. The password is kept as configured.
+	if sasl := monitor.KafkaProducerSASLOptions; sasl != nil && sasl.Mechanism != "" && !strings.EqualFold(sasl.Mechanism, "none") {
+		password := types.StringNull()
+		if data.KafkaProducerSASLOptions != nil {
+			password = data.KafkaProducerSASLOptions.Password
+		}
+		data.KafkaProducerSASLOptions = &KafkaProducerSASLOptionsModel{
+			Mechanism: types.StringValue(sasl.Mechanism),
+			Username:  types.StringValue(sasl.Username),
+			Password:  password,
+		}
+	} else {
+		data.KafkaProducerSASLOptions = nil
+	}
+	data.InvertKeyword = types.BoolValue(monitor.InvertKeyword)
+	data.JSONPath = stringValueOrNull(data.JSONPath, monitor.JSONPath)
+	data.ExpectedValue = stringValueOrNull(data.ExpectedValue, monitor.ExpectedValue)
+	data.ExpiryNotification = types.BoolValue(monitor.ExpiryNotification)
+	data.Timeout = int64ValueOrDefault(monitor.Timeout, 48)
+
+	acceptedStatusCodes := make([]types.String, 0, len(monitor.AcceptedStatusCodes))
+	for _, statusCode := range monitor.AcceptedStatusCodes {
+		acceptedStatusCodes = append(acceptedStatusCodes, types.StringValue(statusCode))
+	}
+	data.AcceptedStatusCodes = acceptedStatusCodes
+
+	// The API stores no encoding until one is chosen, which behaves as json.
+	data.HTTPBodyEncoding = stringValueOrDefault(monitor.HTTPBodyEncoding, "json")
+	data.ProxyID = int64ValueOrNull(monitor.ProxyID)
+
+	notificationIDs := make([]int64, 0, len(monitor.NotificationIDList))
+	for _, notificationID := range monitor.NotificationIDList {
+		notificationIDs = append(notificationIDs, int64(notificationID))
+	}
+	notificationIDSet, diags := types.SetValueFrom(ctx, types.Int64Type, notificationIDs)
+	data.NotificationIDs = notificationIDSet
+
+	// Tags are only tracked when they are managed by this resource.
+	if data.Tags != nil {
+		tags := make([]MonitorTagModel, 0, len(monitor.Tags))
+		for _, tag := range monitor.Tags {
+			tags = append(tags, MonitorTagModel{
+				TagID: types.Int64Value(int64(tag.TagID)),
+				Value: types.StringValue(tag.Value),
+			})
+		}
+		data.Tags = tags
+	}
+
+	return diags
+}
+
+// requireMonitorAttributes reports the given attributes that are missing from the
+// configuration when selector is set to selected.
+func requireMonitorAttributes(ctx context.Context, config tfsdk.Config, selector, selected string, names []string, diags *diag.Diagnostics) {
+	for _, name := range names {
+		var value attr.Value
+		diags.Append(config.GetAttribute(ctx, path.Root(name), &value)...)
+		if value != nil && value.IsNull() {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Missing Monitor Attribute",
+				fmt.Sprintf("%s must be set when %s is %q.", name, selector, selected),
+			)
+		}
+	}
+}
+
+// hasAnyPrefixFold reports whether value starts with one of the prefixes, ignoring case.
+func hasAnyPrefixFold(value string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if len(value) >= len(prefix) && strings.EqualFold(value[:len(prefix)], prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// quoteJoin quotes each value and joins them with sep.
+func quoteJoin(values []string, sep string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, strconv.Quote(value))
+	}
+	return strings.Join(quoted, sep)
+}
+
+// maskDatabaseConnectionString keeps the connection string, which usually embeds
+// credentials, out of the logs.
+func maskDatabaseConnectionString(ctx context.Context, connectionString types.String) context.Context {
+	if connectionString.ValueString() == "" {
+		return ctx
+	}
+	ctx = tflog.MaskMessageStrings(ctx, connectionString.ValueString())
+	return tflog.MaskAllFieldValuesStrings(ctx, connectionString.ValueString())
+}
+
+// stringValueOrDefault returns the value, or the fallback when it is empty.
+func stringValueOrDefault(value, fallback string) types.String {
+	if value == "" {
+		return types.StringValue(fallback)
+	}
+	return types.StringValue(value)
+}
+
+// int64ValueOrNull returns the value, or null when it is zero.
+func int64ValueOrNull(value int) types.Int64 {
+	if value == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(value))
+}
+
+// int64ValueOrDefault returns the value, or the fallback when it is zero.
+func int64ValueOrDefault(value int, fallback int64) types.Int64 {
+	if value == 0 {
+		return types.Int64Value(fallback)
+	}
+	return types.Int64Value(int64(value))
+}
+
+// int64ValueOrNullDefault returns the value read from the API, keeping an unset
+// attribute null when the API returns zero or the default it fills in.
+func int64ValueOrNullDefault(current types.Int64, value int, serverDefault int) types.Int64 {
+	if current.IsNull() && (value == 0 || value == serverDefault) {
+		return types.Int64Null()
+	}
+	return int64ValueOrNull(value)
+}
+
+// stringValueOrNull returns the value read from the API, keeping an unset attribute
+// null when the API returns an empty string.
+func stringValueOrNull(current types.String, value string) types.String {
+	if value == "" && current.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// setPushToken generates the token of a push monitor when none is planned and sets
+// the push URL that follows from it.
+func (r *MonitorResource) setPushToken(data *MonitorResourceModel) error {
+	if data.PushToken.IsUnknown() {
+		data.PushToken = types.StringNull()
+
+		if data.Type.ValueString() == string(client.MonitorTypePush) {
+			pushToken, err := generatePushToken()
+			if err != nil {
+				return err
+			}
+			data.PushToken = types.StringValue(pushToken)
+		}
+	}
+
+	data.PushURL = r.pushURL(data.Type.ValueString(), data.PushToken.ValueString())
+
+	return nil
+}
+
+// readPushToken replaces the push token of a newly created push monitor with the
+// one stored by the API. A configured token the API did not keep is an error.
+func (r *MonitorResource) readPushToken(ctx context.Context, data *MonitorResourceModel, generated bool, diags *diag.Diagnostics) {
+	monitorID := int(data.ID.ValueInt64())
+
+	monitor, err := r.client.GetMonitor(ctx, monitorID)
+	if err != nil {
+		addClientError(diags, fmt.Sprintf("Unable to read push token of monitor %d", monitorID), err)
+		return
+	}
+
+	if monitor.PushToken == data.PushToken.ValueString() {
+		return
+	}
+
+	if !generated {
+		diags.AddError(
+			"Push Token Not Accepted",
+			fmt.Sprintf("Monitor %d was created with push token %q instead of the configured one. Remove push_token to use the token assigned by Uptime Kuma.", monitorID, monitor.PushToken),
+		)
+	}
+
+	data.PushToken = stringValueOrNull(types.StringNull(), monitor.PushToken)
+	data.PushURL = r.pushURL(data.Type.ValueString(), monitor.PushToken)
+}
+
+// pushURL returns the URL a push monitor is reported to, or null for other monitors.
+func (r *MonitorResource) pushURL(monitorType, pushToken string) types.String {
+	if monitorType != string(client.MonitorTypePush) || pushToken == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(fmt.Sprintf("%s/api/push/%s?status=up&msg=OK&ping=", strings.TrimRight(r.baseURL, "/"), pushToken))
+}
+
+// generatePushToken returns a random push token in the format used by the Uptime Kuma UI.
+func generatePushToken() (string, error) {
+	token := make([]byte, monitorPushTokenLength)
+	alphabetSize := big.NewInt(int64(len(monitorPushTokenAlphabet)))
+	for i := range token {
+		n, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", fmt.Errorf("unable to generate push token: %w", err)
+		}
+		token[i] = monitorPushTokenAlphabet[n.Int64()]
+	}
+
+	return string(token), nil
+}
+
+// nullFields returns the API fields of the given optional attributes that are set
+// in the prior state but null in the plan, so the update clears them.
+func nullFields(ctx context.Context, plan tfsdk.Plan, state tfsdk.State, nullableFields map[string]string) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	names := make([]string, 0, len(nullableFields))
+	for name := range nullableFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var fields []string
+	for _, name := range names {
+		var planned, prior attr.Value
+		diags.Append(plan.GetAttribute(ctx, path.Root(name), &planned)...)
+		diags.Append(state.GetAttribute(ctx, path.Root(name), &prior)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if planned.IsNull() && !prior.IsNull() {
+			fields = append(fields, nullableFields[name])
+		}
+	}
+
+	return fields, diags
+}
+
+// notificationIDsFromSet converts the notification_ids set to the list sent to the API.
+func notificationIDsFromSet(ctx context.Context, set types.Set) ([]int, diag.Diagnostics) {
+	notificationIDs := []int{}
+	if set.IsNull() || set.IsUnknown() {
+		return notificationIDs, nil
+	}
+
+	var values []int64
+	diags := set.ElementsAs(ctx, &values, false)
+	for _, value := range values {
+		notificationIDs = append(notificationIDs, int(value))
+	}
+	return notificationIDs, diags
+}
+
+// releaseGroupChildren moves the monitors nested in a group up to the group's own
+// parent, so deleting the group never takes monitors managed elsewhere with it.
+func (r *MonitorResource) releaseGroupChildren(ctx context.Context, groupID int, groupParentID types.Int64) error {
+	monitors, err := r.client.GetMonitors(ctx)
+	if err != nil {
+		return err
+	}
+
+	var parentID *int
+	if !groupParentID.IsNull() {
+		id := int(groupParentID.ValueInt64())
+		parentID = &id
+	}
+
+	for _, monitor := range monitors {
+		if monitor.Parent == nil || *monitor.Parent != groupID {
+			continue
+		}
+
+		tflog.Warn(ctx, "Moving monitor out of deleted group", map[string]interface{}{
+			"id":    monitor.ID,
+			"group": groupID,
+		})
+
+		if err := r.client.SetMonitorParent(ctx, monitor.ID, parentID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// monitorTagKey identifies a monitor tag by tag and value.
+type monitorTagKey struct {
+	tagID int
+	value string
+}
+
+func newMonitorTagKey(tag MonitorTagModel) monitorTagKey {
+	return monitorTagKey{tagID: int(tag.TagID.ValueInt64()), value: tag.Value.ValueString()}
+}
+
+// syncMonitorTags removes the current tags that are not desired and adds the desired
+// tags that are not current.
+func (r *MonitorResource) syncMonitorTags(ctx context.Context, monitorID int, current, desired []MonitorTagModel) error {
+	currentKeys := make(map[monitorTagKey]bool, len(current))
+	for _, tag := range current {
+		currentKeys[newMonitorTagKey(tag)] = true
+	}
+	desiredKeys := make(map[monitorTagKey]bool, len(desired))
+	for _, tag := range desired {
+		desiredKeys[newMonitorTagKey(tag)] = true
+	}
+
+	for key := range currentKeys {
+		if !desiredKeys[key] {
+			if err := r.client.DeleteMonitorTag(ctx, monitorID, key.tagID, key.value); err != nil {
+				return err
+			}
+		}
+	}
+
+	for key := range desiredKeys {
+		if !currentKeys[key] {
+			if err := r.client.AddMonitorTag(ctx, monitorID, key.tagID, key.value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/kill3r-queen/terraform-provider-uptimekuma/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MonitorTagResource{}
+var _ resource.ResourceWithImportState = &MonitorTagResource{}
+
+func NewMonitorTagResource() resource.Resource {
+	return &MonitorTagResource{}
+}
+
+// MonitorTagResource defines the resource implementation.
+type MonitorTagResource struct {
+	client *client.Client
+}
+
+// MonitorTagResourceModel describes the resource data model.
+type MonitorTagResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	MonitorID types.Int64  `tfsdk:"monitor_id"`
+	TagID     types.Int64  `tfsdk:"tag_id"`
+	Value     types.String `tfsdk:"value"`
+}
+
+func (r *MonitorTagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_tag"
+}
+
+func (r *MonitorTagResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Attaches a tag to an Uptime Kuma monitor. Use it instead of the monitor's `tags` attribute when tags are managed separately from the monitor.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Association identifier, formatted as `<monitor_id>:<tag_id>:<value>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"monitor_id": schema.Int64Attribute{
+				MarkdownDescription: "Monitor identifier.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"tag_id": schema.Int64Attribute{
+				MarkdownDescription: "Tag identifier.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Tag value.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *MonitorTagResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*UptimeKumaProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *UptimeKumaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *MonitorTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MonitorTagResourceModel
+
+	// Read Terraform plan data into the model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorID := int(data.MonitorID.ValueInt64())
+	tagID := int(data.TagID.ValueInt64())
+
+	tflog.Info(ctx, "Adding tag to monitor", map[string]interface{}{
+		"monitor_id": monitorID,
+		"tag_id":     tagID,
+	})
+
+	if err := r.client.AddMonitorTag(ctx, monitorID, tagID, data.Value.ValueString()); err != nil {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to add tag %d to monitor %d", tagID, monitorID), err)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%d:%d:%s", monitorID, tagID, data.Value.ValueString()))
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MonitorTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MonitorTagResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorID := int(data.MonitorID.ValueInt64())
+	tagID := int(data.TagID.ValueInt64())
+	tflog.Debug(ctx, "Reading monitor tag from API", map[string]interface{}{"id": data.ID.ValueString()})
+
+	monitor, err := r.client.GetMonitor(ctx, monitorID)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			// Monitor is gone upstream, remove the association from state.
+			tflog.Warn(ctx, "Monitor not found, removing tag from state", map[string]interface{}{"id": data.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to read monitor %d", monitorID), err)
+		return
+	}
+
+	// Check the association still exists.
+	for _, tag := range monitor.Tags {
+		if tag.TagID == tagID && tag.Value == data.Value.ValueString() {
+			// Save updated data into Terraform state.
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	tflog.Warn(ctx, "Monitor tag not found, removing from state", map[string]interface{}{"id": data.ID.ValueString()})
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *MonitorTagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MonitorTagResourceModel
+
+	// Every attribute forces replacement, so there is nothing to send to the API.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MonitorTagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MonitorTagResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorID := int(data.MonitorID.ValueInt64())
+	tagID := int(data.TagID.ValueInt64())
+
+	tflog.Info(ctx, "Removing tag from monitor", map[string]interface{}{
+		"monitor_id": monitorID,
+		"tag_id":     tagID,
+	})
+
+	err := r.client.DeleteMonitorTag(ctx, monitorID, tagID, data.Value.ValueString())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to remove tag %d from monitor %d", tagID, monitorID), err)
+		return
+	}
+}
+
+func (r *MonitorTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The import ID is <monitor_id>:<tag_id>:<value>. The value may itself contain colons.
+	parts := strings.SplitN(req.ID, ":", 3)
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"Invalid Monitor Tag ID",
+			fmt.Sprintf("Monitor tag ID must be formatted as <monitor_id>:<tag_id>:<value>, got: %s", req.ID),
+		)
+		return
+	}
+
+	monitorID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Monitor Tag ID",
+			fmt.Sprintf("Monitor ID must be a number, got: %s", parts[0]),
+		)
+		return
+	}
+
+	tagID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Monitor Tag ID",
+			fmt.Sprintf("Tag ID must be a number, got: %s", parts[1]),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("monitor_id"), monitorID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tag_id"), tagID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("value"), parts[2])...)
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccMonitorTagResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing.
+			{
+				Config: testAccMonitorTagResourceConfig("sre"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_tag.test",
+						tfjsonpath.New("value"),
+						knownvalue.StringExact("sre"),
+					),
+				},
+			},
+			// ImportState testing.
+			{
+				ResourceName:      "uptimekuma_monitor_tag.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Changing the value replaces the association.
+			{
+				Config: testAccMonitorTagResourceConfig("platform"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_tag.test",
+						tfjsonpath.New("value"),
+						knownvalue.StringExact("platform"),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase.
+		},
+	})
+}
+
+func testAccMonitorTagResourceConfig(value string) string {
+	return fmt.Sprintf(`
+resource "uptimekuma_tag" "oncall" {
+name  = "oncall"
+color = "red"
+}
+
+resource "uptimekuma_monitor" "test" {
+name        = "Monitor Tag Monitor"
+type        = "http"
+url         = "https://example.com"
+description = "monitor tag"
+}
+
+resource "uptimekuma_monitor_tag" "test" {
+monitor_id = uptimekuma_monitor.test.id
+tag_id     = uptimekuma_tag.oncall.id
+value      = %[1]q
+}
+`,
+		value)
+}
@@ -5,13 +5,20 @@ package provider
 
 import (
 	"context"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/kill3r-queen/terraform-provider-uptimekuma/internal/client"
@@ -35,7 +42,29 @@ type UptimeKumaProviderModel struct {
 	BaseURL       types.String `tfsdk:"base_url"`
 	Username      types.String `tfsdk:"username"`
 	Password      types.String `tfsdk:"password"`
+	AccessToken   types.String `tfsdk:"access_token"`
+	TOTPSecret    types.String `tfsdk:"totp_secret"`
 	InsecureHTTPS types.Bool   `tfsdk:"insecure_https"`
+	CACertPEM     types.String `tfsdk:"ca_cert_pem"`
+	CACertFile    types.String `tfsdk:"ca_cert_file"`
+	ClientCertPEM types.String `tfsdk:"client_cert_pem"`
+	ClientKeyPEM  types.String `tfsdk:"client_key_pem"`
+	MaxRetries    types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMax  types.Int64  `tfsdk:"retry_wait_max"`
+
+	DefaultNotificationIDs types.Set `tfsdk:"default_notification_ids"`
+}
+
+// UptimeKumaProviderData is passed to resources once the provider is configured.
+type UptimeKumaProviderData struct {
+	Client *client.Client
+
+	// BaseURL is the configured Uptime Kuma URL, used to build push URLs.
+	BaseURL string
+
+	// DefaultNotificationIDs apply to monitors that do not set notification_ids.
+	// A nil slice means no default is configured.
+	DefaultNotificationIDs []int64
 }
 
 func (p *UptimeKumaProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -48,22 +77,86 @@ func (p *UptimeKumaProvider) Schema(ctx context.Context, req provider.SchemaRequ
 		MarkdownDescription: "Interact with Uptime Kuma",
 		Attributes: map[string]schema.Attribute{
 			"base_url": schema.StringAttribute{
-				MarkdownDescription: "Base URL of the Uptime Kuma instance (e.g., http://localhost:3001 or https://uptime.example.com)",
-				Required:            true,
+				MarkdownDescription: "Base URL of the Uptime Kuma instance (e.g., http://localhost:3001 or https://uptime.example.com). May also be set with the `UPTIMEKUMA_BASE_URL` environment variable",
+				Optional:            true,
 			},
 			"username": schema.StringAttribute{
-				MarkdownDescription: "Username for authentication",
-				Required:            true,
+				MarkdownDescription: "Username for authentication. May also be set with the `UPTIMEKUMA_USERNAME` environment variable",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("access_token")),
+				},
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "Password for authentication",
-				Required:            true,
+				MarkdownDescription: "Password for authentication. May also be set with the `UPTIMEKUMA_PASSWORD` environment variable",
+				Optional:            true,
 				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("access_token")),
+				},
+			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "Pre-issued access token used instead of `username` and `password`. May also be set with the `UPTIMEKUMA_ACCESS_TOKEN` environment variable",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"totp_secret": schema.StringAttribute{
+				MarkdownDescription: "Base32 encoded secret of the two-factor authentication (TOTP) device, used to generate a login code when 2FA is enabled. May also be set with the `UPTIMEKUMA_TOTP_SECRET` environment variable",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("access_token")),
+				},
 			},
 			"insecure_https": schema.BoolAttribute{
 				MarkdownDescription: "Skip TLS certificate verification",
 				Optional:            true,
 			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificate(s) trusted in addition to the system roots when verifying the server certificate",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing PEM encoded CA certificate(s) trusted in addition to the system roots",
+				Optional:            true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate presented for mutual TLS",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key_pem")),
+				},
+			},
+			"client_key_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key for `client_cert_pem`",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert_pem")),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of times a failed read request (429, 502, 503 or 504) is retried. Defaults to 3; set to 0 to disable retries",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_max": schema.Int64Attribute{
+				MarkdownDescription: "Maximum time in seconds to wait between retries, including waits requested by a `Retry-After` header. Defaults to 30",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"default_notification_ids": schema.SetAttribute{
+				MarkdownDescription: "Notification IDs applied to every `uptimekuma_monitor` that does not set `notification_ids`",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
 		},
 	}
 }
@@ -77,20 +170,123 @@ func (p *UptimeKumaProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
-	// Configuration values are now available.
-	baseURL := data.BaseURL.ValueString()
-	username := data.Username.ValueString()
-	password := data.Password.ValueString()
+	// Configuration values are now available, falling back to environment variables.
+	baseURL := stringValueOrEnv(data.BaseURL, "UPTIMEKUMA_BASE_URL")
+	username := stringValueOrEnv(data.Username, "UPTIMEKUMA_USERNAME")
+	password := stringValueOrEnv(data.Password, "UPTIMEKUMA_PASSWORD")
+
+	// Credentials set in the configuration take precedence over a token from the environment.
+	accessToken := data.AccessToken.ValueString()
+	if accessToken == "" && data.Username.IsNull() && data.Password.IsNull() {
+		accessToken = os.Getenv("UPTIMEKUMA_ACCESS_TOKEN")
+	}
+	totpSecret := stringValueOrEnv(data.TOTPSecret, "UPTIMEKUMA_TOTP_SECRET")
+	if accessToken != "" {
+		username = ""
+		password = ""
+		totpSecret = ""
+	}
+
+	if baseURL == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("base_url"),
+			"Missing Uptime Kuma Base URL",
+			"The provider cannot create the Uptime Kuma API client because the base URL is not set. "+
+				"Set base_url in the provider configuration or the UPTIMEKUMA_BASE_URL environment variable.",
+		)
+	}
+
+	if accessToken == "" && (username == "" || password == "") {
+		resp.Diagnostics.AddError(
+			"Missing Uptime Kuma Credentials",
+			"The provider cannot create the Uptime Kuma API client because no credentials are set. "+
+				"Set access_token, or both username and password, in the provider configuration or with the "+
+				"UPTIMEKUMA_ACCESS_TOKEN, UPTIMEKUMA_USERNAME and UPTIMEKUMA_PASSWORD environment variables.",
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	insecureHTTPS := false
 	if !data.InsecureHTTPS.IsNull() {
 		insecureHTTPS = data.InsecureHTTPS.ValueBool()
 	}
 
+	caCertPEM := data.CACertPEM.ValueString()
+	if !data.CACertFile.IsNull() {
+		contents, err := os.ReadFile(data.CACertFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_cert_file"),
+				"Unable to Read CA Certificate File",
+				"The CA certificate file could not be read: "+err.Error(),
+			)
+			return
+		}
+		caCertPEM = string(contents)
+	}
+
+	if caCertPEM != "" {
+		if _, err := client.ParseCACertPEM(caCertPEM); err != nil {
+			attribute := path.Root("ca_cert_pem")
+			if !data.CACertFile.IsNull() {
+				attribute = path.Root("ca_cert_file")
+			}
+			resp.Diagnostics.AddAttributeError(
+				attribute,
+				"Invalid CA Certificate",
+				"The CA certificate could not be parsed: "+err.Error(),
+			)
+		}
+	}
+
+	if totpSecret != "" {
+		if _, err := client.GenerateTOTP(totpSecret, time.Now()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("totp_secret"),
+				"Invalid TOTP Secret",
+				"The TOTP secret must be the base32 encoded secret shown when two-factor authentication was enabled: "+err.Error(),
+			)
+		}
+	}
+
+	clientCertPEM := data.ClientCertPEM.ValueString()
+	clientKeyPEM := data.ClientKeyPEM.ValueString()
+	if clientCertPEM != "" && clientKeyPEM != "" {
+		if _, err := client.ParseClientCertificate(clientCertPEM, clientKeyPEM); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("client_cert_pem"),
+				"Invalid Client Certificate",
+				"The client certificate and key could not be loaded: "+err.Error(),
+			)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	retryPolicy := client.DefaultRetryPolicy()
+	if !data.MaxRetries.IsNull() {
+		retryPolicy.MaxAttempts = int(data.MaxRetries.ValueInt64()) + 1
+	}
+	if !data.RetryWaitMax.IsNull() {
+		retryPolicy.MaxBackoff = time.Duration(data.RetryWaitMax.ValueInt64()) * time.Second
+	}
+
 	config := &client.Config{
 		BaseURL:       baseURL,
 		Username:      username,
 		Password:      password,
+		AccessToken:   accessToken,
+		TOTPSecret:    totpSecret,
 		InsecureHTTPS: insecureHTTPS,
+		CACertPEM:     caCertPEM,
+		ClientCertPEM: clientCertPEM,
+		ClientKeyPEM:  clientKeyPEM,
+		RetryPolicy:   retryPolicy,
 	}
 
 	// Create client.
@@ -105,14 +301,31 @@ func (p *UptimeKumaProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
-	resp.DataSourceData = apiClient
-	resp.ResourceData = apiClient
+	providerData := &UptimeKumaProviderData{
+		Client:  apiClient,
+		BaseURL: baseURL,
+	}
+
+	if !data.DefaultNotificationIDs.IsNull() && !data.DefaultNotificationIDs.IsUnknown() {
+		providerData.DefaultNotificationIDs = []int64{}
+		resp.Diagnostics.Append(data.DefaultNotificationIDs.ElementsAs(ctx, &providerData.DefaultNotificationIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
 }
 
 func (p *UptimeKumaProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewMonitorResource,
 		NewStatusPageResource,
+		NewMaintenanceResource,
+		NewMaintenanceMonitorsResource,
+		NewTagResource,
+		NewMonitorTagResource,
 	}
 }
 
@@ -134,6 +347,14 @@ func (p *UptimeKumaProvider) Functions(ctx context.Context) []func() function.Fu
 	}
 }
 
+// stringValueOrEnv returns the configured value, or the environment variable when it is not set.
+func stringValueOrEnv(value types.String, env string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+	return os.Getenv(env)
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &UptimeKumaProvider{
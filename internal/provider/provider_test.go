@@ -30,8 +30,16 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 
 func testAccPreCheck(t *testing.T) {
 	// Check for required environment variables for acceptance tests.
+	if v := os.Getenv("UPTIMEKUMA_BASE_URL"); v == "" {
+		t.Fatal("UPTIMEKUMA_BASE_URL environment variable must be set for acceptance tests")
+	}
+
+	// A pre-issued access token can be used instead of a username and password.
+	if os.Getenv("UPTIMEKUMA_ACCESS_TOKEN") != "" {
+		return
+	}
+
 	requiredEnvVars := []string{
-		"UPTIMEKUMA_BASE_URL",
 		"UPTIMEKUMA_USERNAME",
 		"UPTIMEKUMA_PASSWORD",
 	}
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -168,18 +169,18 @@ func (r *StatusPageResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	providerData, ok := req.ProviderData.(*UptimeKumaProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *UptimeKumaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
 }
 
 func (r *StatusPageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -205,7 +206,7 @@ func (r *StatusPageResource) Create(ctx context.Context, req resource.CreateRequ
 
 	createResp, err := r.client.CreateStatusPage(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create status page: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create status page", err)
 		return
 	}
 
@@ -213,10 +214,26 @@ func (r *StatusPageResource) Create(ctx context.Context, req resource.CreateRequ
 		"message": createResp.Msg,
 	})
 
+	// Save the slug right away. If a later step fails, the page is tracked as a
+	// tainted resource and replaced on the next apply, instead of being left
+	// behind and blocking the slug.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("slug"), data.Slug)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("title"), data.Title)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Now get the status page to find its ID and other details.
 	createdPage, err := r.client.GetStatusPage(ctx, data.Slug.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to retrieve created status page: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to retrieve created status page", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(createdPage.ID))...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -289,7 +306,7 @@ func (r *StatusPageResource) Create(ctx context.Context, req resource.CreateRequ
 	// Update the status page with all attributes.
 	_, err = r.client.UpdateStatusPage(ctx, data.Slug.ValueString(), updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update status page attributes: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to update status page attributes", err)
 		return
 	}
 
@@ -313,7 +330,14 @@ func (r *StatusPageResource) Read(ctx context.Context, req resource.ReadRequest,
 	// Read status page from API.
 	statusPage, err := r.client.GetStatusPage(ctx, data.Slug.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read status page '%s': %s", data.Slug.ValueString(), err))
+		if errors.Is(err, client.ErrNotFound) {
+			// Resource is gone upstream, remove it from state.
+			tflog.Warn(ctx, "Status page not found, removing from state", map[string]interface{}{"slug": data.Slug.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to read status page '%s'", data.Slug.ValueString()), err)
 		return
 	}
 
@@ -445,14 +469,14 @@ func (r *StatusPageResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	_, err := r.client.UpdateStatusPage(ctx, data.Slug.ValueString(), updateRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update status page: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to update status page", err)
 		return
 	}
 
 	// Refresh the data from the API.
 	updatedPage, err := r.client.GetStatusPage(ctx, data.Slug.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read updated status page: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to read updated status page", err)
 		return
 	}
 
@@ -485,8 +509,8 @@ func (r *StatusPageResource) Delete(ctx context.Context, req resource.DeleteRequ
 	})
 
 	_, err := r.client.DeleteStatusPage(ctx, data.Slug.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete status page '%s': %s", data.Slug.ValueString(), err))
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to delete status page '%s'", data.Slug.ValueString()), err)
 		return
 	}
 }
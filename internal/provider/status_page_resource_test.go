@@ -4,14 +4,27 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/kill3r-queen/terraform-provider-uptimekuma/internal/client"
 )
 
 func TestAccStatusPageResource(t *testing.T) {
@@ -85,24 +98,15 @@ func TestAccStatusPageResource(t *testing.T) {
 // Note: This config function definition remains unchanged.
 func testAccStatusPageResourceConfig(slug, title, description string) string {
 	return fmt.Sprintf(`
-provider "uptimekuma" {
-  base_url = "%s"
-  username = "%s"
-  password = "%s"
-}
-
 resource "uptimekuma_status_page" "test" {
-  slug        = %[4]q
-  title       = %[5]q
-  description = %[6]q
+  slug        = %[1]q
+  title       = %[2]q
+  description = %[3]q
   published   = true
   theme       = "dark"
   show_tags   = false
 }
 `,
-		os.Getenv("UPTIMEKUMA_BASE_URL"),
-		os.Getenv("UPTIMEKUMA_USERNAME"),
-		os.Getenv("UPTIMEKUMA_PASSWORD"),
 		slug, title, description)
 }
 
@@ -163,12 +167,6 @@ func TestAccStatusPageResourceWithGroups(t *testing.T) {
 // Note: This config function definition remains unchanged.
 func testAccStatusPageResourceWithGroupsConfig(slug, title string) string {
 	return fmt.Sprintf(`
-provider "uptimekuma" {
-  base_url = "%s"
-  username = "%s"
-  password = "%s"
-}
-
 // Define dependent monitors for the group test
 resource "uptimekuma_monitor" "http1" {
   name     = "HTTP Monitor 1 for Group Test" // Make names unique for testing
@@ -185,8 +183,8 @@ resource "uptimekuma_monitor" "http2" {
 }
 
 resource "uptimekuma_status_page" "with_groups" {
-  slug      = %[4]q
-  title     = %[5]q
+  slug      = %[1]q
+  title     = %[2]q
   published = true
   theme     = "dark"
 
@@ -207,8 +205,130 @@ resource "uptimekuma_status_page" "with_groups" {
   }
 }
 `,
-		os.Getenv("UPTIMEKUMA_BASE_URL"),
-		os.Getenv("UPTIMEKUMA_USERNAME"),
-		os.Getenv("UPTIMEKUMA_PASSWORD"),
 		slug, title)
 }
+
+// TestStatusPageResourceCreatePartialFailure checks that a status page is kept in
+// state once it exists on the server, whichever later step of Create fails.
+func TestStatusPageResourceCreatePartialFailure(t *testing.T) {
+	tests := map[string]struct {
+		failStep   string
+		expectSlug bool
+		expectID   bool
+	}{
+		"create fails": {failStep: "create"},
+		"get fails":    {failStep: "get", expectSlug: true},
+		"update fails": {failStep: "update", expectSlug: true, expectID: true},
+		"no failure":   {expectSlug: true, expectID: true},
+	}
+
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	(&StatusPageResource{}).Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				step := ""
+				switch {
+				case r.URL.Path == "/login/access-token":
+					_ = json.NewEncoder(w).Encode(client.TokenResponse{AccessToken: "test-token-12345", TokenType: "Bearer"})
+					return
+				case r.URL.Path == "/status-pages" && r.Method == http.MethodPost:
+					step = "create"
+				case r.URL.Path == "/status-pages/status" && r.Method == http.MethodGet:
+					step = "get"
+				case r.URL.Path == "/status-pages/status" && r.Method == http.MethodPost:
+					step = "update"
+				default:
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+
+				if step == test.failStep {
+					w.WriteHeader(http.StatusInternalServerError)
+					_, _ = w.Write([]byte(`{"detail":"injected failure"}`))
+					return
+				}
+
+				switch step {
+				case "create":
+					_, _ = w.Write([]byte(`{"msg":"Added Successfully."}`))
+				case "get":
+					_ = json.NewEncoder(w).Encode(client.StatusPage{ID: 7, Slug: "status", Title: "Status"})
+				case "update":
+					_, _ = w.Write([]byte(`{"detail":"Saved."}`))
+				}
+			}))
+			defer server.Close()
+
+			apiClient, err := client.New(&client.Config{
+				BaseURL:     server.URL,
+				Username:    "testuser",
+				Password:    "testpass",
+				Timeout:     5 * time.Second,
+				RetryPolicy: &client.RetryPolicy{MaxAttempts: 1},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create client: %v", err)
+			}
+
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			diags := plan.Set(ctx, &StatusPageResourceModel{
+				ID:                types.Int64Unknown(),
+				Slug:              types.StringValue("status"),
+				Title:             types.StringValue("Status"),
+				Description:       types.StringNull(),
+				Theme:             types.StringNull(),
+				Published:         types.BoolValue(true),
+				ShowTags:          types.BoolValue(false),
+				FooterText:        types.StringNull(),
+				CustomCSS:         types.StringNull(),
+				GoogleAnalyticsID: types.StringNull(),
+				Icon:              types.StringNull(),
+				ShowPoweredBy:     types.BoolValue(true),
+			})
+			if diags.HasError() {
+				t.Fatalf("Failed to set plan: %v", diags)
+			}
+
+			req := fwresource.CreateRequest{Plan: plan}
+			resp := &fwresource.CreateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+
+			(&StatusPageResource{client: apiClient}).Create(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() != (test.failStep != "") {
+				t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if !test.expectSlug {
+				if !resp.State.Raw.IsNull() {
+					t.Errorf("Expected no state, got %v", resp.State.Raw)
+				}
+				return
+			}
+
+			var slug types.String
+			var id types.Int64
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("slug"), &slug)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+			if slug.ValueString() != "status" {
+				t.Errorf("Expected slug to be saved, got %s", slug)
+			}
+			if test.expectID && id.ValueInt64() != 7 {
+				t.Errorf("Expected id 7 to be saved, got %s", id)
+			}
+			if !test.expectID && !id.IsNull() {
+				t.Errorf("Expected no id, got %s", id)
+			}
+		})
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/kill3r-queen/terraform-provider-uptimekuma/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TagResource{}
+var _ resource.ResourceWithImportState = &TagResource{}
+
+// tagColorPalette maps the named colours offered by the Uptime Kuma UI to their hex values.
+var tagColorPalette = map[string]string{
+	"grey":   "#4B5563",
+	"red":    "#DC2626",
+	"orange": "#D97706",
+	"green":  "#059669",
+	"blue":   "#2563EB",
+	"indigo": "#4F46E5",
+	"purple": "#7C3AED",
+	"pink":   "#DB2777",
+}
+
+var tagHexColorRegexp = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+func NewTagResource() resource.Resource {
+	return &TagResource{}
+}
+
+// TagResource defines the resource implementation.
+type TagResource struct {
+	client *client.Client
+}
+
+// TagResourceModel describes the resource data model.
+type TagResourceModel struct {
+	ID    types.Int64  `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Color types.String `tfsdk:"color"`
+}
+
+func (r *TagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag"
+}
+
+func (r *TagResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an Uptime Kuma tag.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Tag identifier.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Tag name.",
+				Required:            true,
+			},
+			"color": schema.StringAttribute{
+				MarkdownDescription: "Tag colour, either a hex value such as `#2563EB` or one of the Uptime Kuma palette colours: `grey`, `red`, `orange`, `green`, `blue`, `indigo`, `purple` or `pink`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.Any(
+						stringvalidator.RegexMatches(tagHexColorRegexp, "must be a hex colour such as #2563EB"),
+						stringvalidator.OneOfCaseInsensitive(tagColorPaletteNames()...),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *TagResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*UptimeKumaProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *UptimeKumaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TagResourceModel
+
+	// Read Terraform plan data into the model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tag := &client.Tag{
+		Name:  data.Name.ValueString(),
+		Color: resolveTagColor(data.Color.ValueString()),
+	}
+
+	tflog.Info(ctx, "Creating tag", map[string]interface{}{
+		"name": tag.Name,
+	})
+
+	createdTag, err := r.client.CreateTag(ctx, tag)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to create tag", err)
+		return
+	}
+
+	data.ID = types.Int64Value(int64(createdTag.ID))
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TagResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagID := int(data.ID.ValueInt64())
+	tflog.Debug(ctx, "Reading tag from API", map[string]interface{}{"id": tagID})
+
+	tag, err := r.client.GetTag(ctx, tagID)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			// Resource is gone upstream, remove it from state.
+			tflog.Warn(ctx, "Tag not found, removing from state", map[string]interface{}{"id": tagID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to read tag %d", tagID), err)
+		return
+	}
+
+	data.ID = types.Int64Value(int64(tag.ID))
+	data.Name = types.StringValue(tag.Name)
+
+	// Keep a configured palette name or hex spelling when it resolves to the stored colour.
+	if data.Color.IsNull() || !strings.EqualFold(resolveTagColor(data.Color.ValueString()), tag.Color) {
+		data.Color = types.StringValue(tag.Color)
+	}
+
+	// Save updated data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TagResourceModel
+
+	// Read Terraform plan data into the model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagID := int(data.ID.ValueInt64())
+	tag := &client.Tag{
+		Name:  data.Name.ValueString(),
+		Color: resolveTagColor(data.Color.ValueString()),
+	}
+
+	tflog.Info(ctx, "Updating tag", map[string]interface{}{
+		"id":   tagID,
+		"name": tag.Name,
+	})
+
+	if err := r.client.UpdateTag(ctx, tagID, tag); err != nil {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to update tag %d", tagID), err)
+		return
+	}
+
+	// Save updated data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TagResourceModel
+
+	// Read Terraform prior state data into the model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagID := int(data.ID.ValueInt64())
+
+	tflog.Info(ctx, "Deleting tag", map[string]interface{}{
+		"id": tagID,
+	})
+
+	err := r.client.DeleteTag(ctx, tagID)
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to delete tag %d", tagID), err)
+		return
+	}
+}
+
+func (r *TagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Tag ID",
+			fmt.Sprintf("Tag ID must be a number, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// resolveTagColor returns the hex value of a palette colour name, or the colour unchanged.
+func resolveTagColor(color string) string {
+	if hex, ok := tagColorPalette[strings.ToLower(color)]; ok {
+		return hex
+	}
+	return color
+}
+
+// tagColorPaletteNames returns the palette colour names in sorted order.
+func tagColorPaletteNames() []string {
+	names := make([]string, 0, len(tagColorPalette))
+	for name := range tagColorPalette {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTagResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing.
+			{
+				Config: testAccTagResourceConfig("production", "#059669"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_tag.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("production"),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_tag.test",
+						tfjsonpath.New("color"),
+						knownvalue.StringExact("#059669"),
+					),
+				},
+			},
+			// ImportState testing.
+			{
+				ResourceName:      "uptimekuma_tag.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update in place with a palette colour.
+			{
+				Config: testAccTagResourceConfig("prod", "red"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("uptimekuma_tag.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_tag.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("prod"),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_tag.test",
+						tfjsonpath.New("color"),
+						knownvalue.StringExact("red"),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase.
+		},
+	})
+}
+
+func testAccTagResourceConfig(name, color string) string {
+	return fmt.Sprintf(`
+resource "uptimekuma_tag" "test" {
+name  = %[1]q
+color = %[2]q
+}
+`,
+		name, color)
+}